@@ -9,8 +9,12 @@ from typing import Dict, List, Optional, Tuple
 import numpy as np
 import pandas as pd
 from datetime import datetime
+import json
 import math
 
+from .coordinates import angular_separation, great_circle_separation
+from .zodiac import ZodiacCalculator
+
 
 class AspectsCalculator:
     """
@@ -55,18 +59,127 @@ class AspectsCalculator:
         'Pluto': 0.8
     }
     
-    def __init__(self, include_minor_aspects: bool = True):
+    # Traditional planetary moieties (half-orbs), used by the 'moiety' orb mode
+    PLANET_MOIETIES = {
+        'Sun': 7.5,
+        'Moon': 6.0,
+        'Mercury': 3.5,
+        'Venus': 3.5,
+        'Mars': 3.5,
+        'Jupiter': 4.5,
+        'Saturn': 4.5,
+        'Uranus': 2.5,
+        'Neptune': 2.5,
+        'Pluto': 2.5
+    }
+    
+    # Orb policies supported by find_aspects_between_planets
+    ORB_MODES = ('aspect', 'moiety')
+    
+    # Curves mapping orb difference to exactness (strength)
+    STRENGTH_MODELS = ('linear', 'gaussian', 'cosine')
+    
+    # Width of the gaussian strength curve as a fraction of the allowed orb
+    GAUSSIAN_WIDTH = 1 / 3
+    
+    # Fraction of exactness kept by dissociate (out-of-sign) aspects
+    DISSOCIATE_STRENGTH_FACTOR = 0.5
+    
+    # Weight of minor aspects relative to major ones in the aspect valence
+    MINOR_VALENCE_WEIGHT = 0.5
+    
+    # Luminaries and personal planets weighed for chart compatibility
+    COMPATIBILITY_PLANETS = ['Sun', 'Moon', 'Mercury', 'Venus', 'Mars']
+    
+    def __init__(self,
+                 include_minor_aspects: bool = True,
+                 use_moon_latitude: bool = False,
+                 orb_mode: str = 'aspect',
+                 tag_lunation: bool = False,
+                 detect_dissociate: bool = False,
+                 strength_model: str = 'linear',
+                 zodiac_calculator: Optional[ZodiacCalculator] = None):
         """
         Initialize the AspectsCalculator.
         
         Args:
             include_minor_aspects: Whether to include minor aspects in calculations
+            use_moon_latitude: Whether aspects involving the Moon should use the
+                              true great-circle separation including ecliptic latitude
+            orb_mode: 'aspect' to scale each aspect's orb by the planets involved,
+                     or 'moiety' to allow the sum of the two planets' moieties
+                     for the major aspects
+            tag_lunation: Whether Sun-Moon aspects should be described with the
+                         current lunar phase
+            detect_dissociate: Whether to flag aspects whose signs don't match the
+                              aspect (e.g. a trine between non-trine signs) and
+                              reduce their exactness
+            strength_model: How exactness falls off across the orb: 'linear',
+                           'gaussian' or 'cosine' (raised cosine)
+            zodiac_calculator: Calculator used for signs, rulers and lunar phases,
+                              so a reference offset (e.g. an ayanamsa) is honoured.
+                              If None, a tropical ZodiacCalculator is used.
         """
+        if orb_mode not in self.ORB_MODES:
+            raise ValueError(f"Unknown orb mode '{orb_mode}', expected one of {self.ORB_MODES}")
+        if strength_model not in self.STRENGTH_MODELS:
+            raise ValueError(
+                f"Unknown strength model '{strength_model}', expected one of {self.STRENGTH_MODELS}"
+            )
+        
         self.include_minor_aspects = include_minor_aspects
+        self.use_moon_latitude = use_moon_latitude
+        self.orb_mode = orb_mode
+        self.tag_lunation = tag_lunation
+        self.detect_dissociate = detect_dissociate
+        self.strength_model = strength_model
+        if zodiac_calculator is None:
+            zodiac_calculator = ZodiacCalculator()
+        self.zodiac_calculator = zodiac_calculator
         self.aspects = self.MAJOR_ASPECTS.copy()
         if include_minor_aspects:
             self.aspects.update(self.MINOR_ASPECTS)
     
+    def register_aspect_type(self,
+                             name: str,
+                             degrees: float,
+                             orb: float,
+                             symbol: Optional[str] = None,
+                             nature: str = 'Neutral') -> None:
+        """
+        Register a custom aspect to be included in aspect calculations.
+        
+        Args:
+            name: Name of the aspect (e.g., 'Centile')
+            degrees: Exact angle of the aspect in degrees (0-180)
+            orb: Allowed orb in degrees (must be positive)
+            symbol: Display symbol (defaults to the first letter of the name)
+            nature: Nature of the aspect (Harmonious, Challenging, etc.)
+            
+        Raises:
+            ValueError: If the angle is outside 0-180 or the orb is not positive
+        """
+        if not 0 <= degrees <= 180:
+            raise ValueError(f"Aspect angle must be between 0 and 180 degrees, got {degrees}")
+        if orb <= 0:
+            raise ValueError(f"Aspect orb must be positive, got {orb}")
+        
+        self.aspects[name] = {
+            'degrees': degrees,
+            'orb': orb,
+            'symbol': symbol or name[:1],
+            'nature': nature
+        }
+    
+    def unregister_aspect_type(self, name: str) -> None:
+        """
+        Remove an aspect from the calculations.
+        
+        Args:
+            name: Name of the aspect to remove
+        """
+        self.aspects.pop(name, None)
+    
     def calculate_angular_distance(self, pos1: float, pos2: float) -> float:
         """
         Calculate the angular distance between two positions.
@@ -78,21 +191,68 @@ class AspectsCalculator:
         Returns:
             Angular distance in degrees (0-180)
         """
-        # Normalize positions to 0-360
-        pos1 = pos1 % 360
-        pos2 = pos2 % 360
+        return angular_separation(pos1, pos2)
+    
+    def calculate_orb(self,
+                      planet1: str,
+                      planet2: str,
+                      base_orb: float,
+                      aspect_name: Optional[str] = None) -> float:
+        """
+        Calculate the allowed orb for an aspect between two planets.
         
-        # Calculate difference
-        diff = abs(pos2 - pos1)
+        Moieties only govern the major aspects; minor and custom aspects keep
+        their own orb scaled by the planets involved, even in 'moiety' mode.
         
-        # Return the smaller angle
-        return min(diff, 360 - diff)
+        Args:
+            planet1: Name of first planet
+            planet2: Name of second planet
+            base_orb: Default orb of the aspect in degrees
+            aspect_name: Name of the aspect. If None, it is treated as a
+                        major aspect.
+            
+        Returns:
+            Allowed orb in degrees under the configured orb mode
+        """
+        if self.orb_mode == 'moiety' and (aspect_name is None or aspect_name in self.MAJOR_ASPECTS):
+            return (self.PLANET_MOIETIES.get(planet1, 2.5) +
+                    self.PLANET_MOIETIES.get(planet2, 2.5))
+        
+        # Adjust orb based on planets involved
+        orb_adjustment = max(
+            self.PLANET_ORB_ADJUSTMENTS.get(planet1, 1.0),
+            self.PLANET_ORB_ADJUSTMENTS.get(planet2, 1.0)
+        )
+        return base_orb * orb_adjustment
+    
+    def calculate_exactness(self, orb_difference: float, orb: float) -> float:
+        """
+        Convert the distance from an exact aspect into a strength percentage.
+        
+        Args:
+            orb_difference: Distance from the exact aspect angle in degrees
+            orb: Allowed orb in degrees
+            
+        Returns:
+            Exactness from 100 (exact) falling toward 0 at the edge of the orb,
+            following the configured strength model
+        """
+        fraction = orb_difference / orb
+        
+        if self.strength_model == 'gaussian':
+            return 100 * math.exp(-fraction ** 2 / (2 * self.GAUSSIAN_WIDTH ** 2))
+        if self.strength_model == 'cosine':
+            return 100 * (1 + math.cos(math.pi * fraction)) / 2
+        
+        return (1 - fraction) * 100
     
     def find_aspects_between_planets(self, 
                                    planet1: str, 
                                    pos1: float,
                                    planet2: str,
-                                   pos2: float) -> List[Dict[str, any]]:
+                                   pos2: float,
+                                   lat1: Optional[float] = None,
+                                   lat2: Optional[float] = None) -> List[Dict[str, any]]:
         """
         Find all aspects between two planets.
         
@@ -101,35 +261,43 @@ class AspectsCalculator:
             pos1: Position of first planet in degrees
             planet2: Name of second planet
             pos2: Position of second planet in degrees
+            lat1: Ecliptic latitude of first planet in degrees (optional)
+            lat2: Ecliptic latitude of second planet in degrees (optional)
             
         Returns:
             List of dictionaries containing aspect information
         """
         aspects_found = []
-        angular_distance = self.calculate_angular_distance(pos1, pos2)
+        
+        # The Moon strays up to ~5° from the ecliptic, so optionally measure
+        # its aspects along the great circle rather than in longitude only
+        if (self.use_moon_latitude and 'Moon' in (planet1, planet2)
+                and lat1 is not None and lat2 is not None):
+            angular_distance = great_circle_separation(pos1, lat1, pos2, lat2)
+        else:
+            angular_distance = self.calculate_angular_distance(pos1, pos2)
         
         for aspect_name, aspect_info in self.aspects.items():
             aspect_degrees = aspect_info['degrees']
             base_orb = aspect_info['orb']
             
-            # Adjust orb based on planets involved
-            orb_adjustment = max(
-                self.PLANET_ORB_ADJUSTMENTS.get(planet1, 1.0),
-                self.PLANET_ORB_ADJUSTMENTS.get(planet2, 1.0)
-            )
-            adjusted_orb = base_orb * orb_adjustment
+            adjusted_orb = self.calculate_orb(planet1, planet2, base_orb, aspect_name)
             
             # Check if angular distance is within orb of the aspect
             orb_difference = abs(angular_distance - aspect_degrees)
             
             if orb_difference <= adjusted_orb:
                 # Calculate exact orb (how close to perfect aspect)
-                exactness = (adjusted_orb - orb_difference) / adjusted_orb * 100
+                exactness = self.calculate_exactness(orb_difference, adjusted_orb)
                 
                 # Determine if aspect is applying or separating
                 # (This would require planetary motion data for accuracy)
                 applying = "Unknown"  # Placeholder
                 
+                dissociate = self.detect_dissociate and self.is_dissociate(pos1, pos2, aspect_degrees)
+                if dissociate:
+                    exactness *= self.DISSOCIATE_STRENGTH_FACTOR
+                
                 aspects_found.append({
                     'aspect': aspect_name,
                     'planet1': planet1,
@@ -143,15 +311,176 @@ class AspectsCalculator:
                     'applying': applying,
                     'angular_distance': angular_distance
                 })
+                if self.detect_dissociate:
+                    aspects_found[-1]['dissociate'] = dissociate
         
         return aspects_found
     
-    def calculate_all_aspects(self, planetary_data: pd.DataFrame) -> pd.DataFrame:
+    def is_dissociate(self, pos1: float, pos2: float, aspect_degrees: float) -> bool:
+        """
+        Check whether an aspect falls between signs that don't form it.
+        
+        Only aspects that are whole multiples of 30° have sign-based
+        counterparts; all others are never dissociate.
+        
+        Args:
+            pos1: Position of first planet in degrees
+            pos2: Position of second planet in degrees
+            aspect_degrees: Exact angle of the aspect in degrees
+            
+        Returns:
+            True if the planets' signs are not the aspect's sign distance apart
+        """
+        if aspect_degrees % 30 != 0:
+            return False
+        
+        sign1 = int(self.zodiac_calculator.to_zodiac_longitude(pos1) // 30)
+        sign2 = int(self.zodiac_calculator.to_zodiac_longitude(pos2) // 30)
+        sign_distance = (sign2 - sign1) % 12
+        
+        return min(sign_distance, 12 - sign_distance) != aspect_degrees // 30
+    
+    def is_mutually_applying(self,
+                             pos1: float,
+                             speed1: float,
+                             pos2: float,
+                             speed2: float,
+                             aspect_degrees: float) -> bool:
+        """
+        Check whether both planets are moving toward an exact aspect.
+        
+        An aspect applies mutually when each planet's own motion closes the
+        gap, rather than one planet simply catching up with the other.
+        
+        Args:
+            pos1: Position of first planet in degrees
+            speed1: Daily motion of first planet in degrees (negative if retrograde)
+            pos2: Position of second planet in degrees
+            speed2: Daily motion of second planet in degrees (negative if retrograde)
+            aspect_degrees: Exact angle of the aspect in degrees
+            
+        Returns:
+            True if both planets reduce the distance from exactness
+        """
+        rates = self._exactness_rates(pos1, speed1, pos2, speed2, aspect_degrees)
+        
+        return rates is not None and rates[0] < 0 and rates[1] < 0
+    
+    def is_applying(self,
+                    pos1: float,
+                    speed1: float,
+                    pos2: float,
+                    speed2: float,
+                    aspect_degrees: float) -> bool:
+        """
+        Check whether two planets are moving toward an exact aspect.
+        
+        Unlike is_mutually_applying, the aspect applies whenever the planets'
+        combined motion closes the gap, including a faster planet catching
+        up with a slower one.
+        
+        Args:
+            pos1: Position of first planet in degrees
+            speed1: Daily motion of first planet in degrees (negative if retrograde)
+            pos2: Position of second planet in degrees
+            speed2: Daily motion of second planet in degrees (negative if retrograde)
+            aspect_degrees: Exact angle of the aspect in degrees
+            
+        Returns:
+            True if the distance from exactness is shrinking
+        """
+        rates = self._exactness_rates(pos1, speed1, pos2, speed2, aspect_degrees)
+        
+        return rates is not None and sum(rates) < 0
+    
+    def _exactness_rates(self,
+                         pos1: float,
+                         speed1: float,
+                         pos2: float,
+                         speed2: float,
+                         aspect_degrees: float) -> Optional[Tuple[float, float]]:
+        """
+        Split the daily change in distance from an exact aspect by planet.
+        
+        Returns:
+            Tuple of how fast each planet's motion grows the distance from
+            exactness (negative when closing it), or None if the aspect is exact
+        """
+        # Signed separation: positive when the second planet is ahead
+        separation = (pos2 - pos1 + 180) % 360 - 180
+        error = abs(separation) - aspect_degrees
+        if error == 0:
+            return None
+        
+        direction = 1 if separation >= 0 else -1
+        sign = 1 if error > 0 else -1
+        
+        return -direction * speed1 * sign, direction * speed2 * sign
+    
+    def find_translations_of_light(self,
+                                   planetary_data: pd.DataFrame,
+                                   speeds: Dict[str, float]) -> List[Tuple[str, str, str]]:
+        """
+        Find planets translating light from one body to another.
+        
+        A planet translates light when it moves faster than two others and is
+        separating from an aspect with the first while applying to an aspect
+        with the second. Whether the other two aspect each other is not
+        considered.
+        
+        Args:
+            planetary_data: DataFrame with planetary positions
+            speeds: Daily motion of each planet in degrees, e.g. from
+                   AstroDataFetcher.get_planet_speeds. Planets without a
+                   speed are skipped.
+            
+        Returns:
+            List of (translator, planet separated from, planet applied to)
+            tuples in the order of planetary_data
+        """
+        if 'Ecliptic_Longitude' not in planetary_data.columns:
+            raise ValueError("DataFrame must contain 'Ecliptic_Longitude' column")
+        
+        positions = {row['Planet']: row['Ecliptic_Longitude']
+                     for _, row in planetary_data.iterrows() if row['Planet'] in speeds}
+        
+        def closest_aspect(planet1, planet2):
+            aspects = self.find_aspects_between_planets(planet1, positions[planet1],
+                                                        planet2, positions[planet2])
+            return min(aspects, key=lambda aspect: aspect['orb_difference']) if aspects else None
+        
+        translations = []
+        for translator in positions:
+            separating_from = []
+            applying_to = []
+            for other in positions:
+                if other == translator or abs(speeds[other]) >= abs(speeds[translator]):
+                    continue
+                aspect = closest_aspect(translator, other)
+                if aspect is None:
+                    continue
+                if self.is_applying(positions[translator], speeds[translator],
+                                    positions[other], speeds[other], aspect['degrees']):
+                    applying_to.append(other)
+                elif aspect['orb_difference'] > 0:
+                    separating_from.append(other)
+            
+            translations.extend((translator, source, target)
+                                for source in separating_from for target in applying_to)
+        
+        return translations
+    
+    def calculate_all_aspects(self,
+                              planetary_data: pd.DataFrame,
+                              angles: Optional[Dict[str, float]] = None) -> pd.DataFrame:
         """
         Calculate all aspects between all planets in the dataset.
         
         Args:
             planetary_data: DataFrame with planetary positions
+            angles: Chart angles to include as pseudo-points, e.g. from
+                   HousesCalculator.get_chart_angles (optional). Aspects
+                   between two angles are skipped.
             
         Returns:
             DataFrame with all aspects found
@@ -162,20 +491,175 @@ class AspectsCalculator:
         aspects_list = []
         planets = planetary_data['Planet'].tolist()
         positions = planetary_data['Ecliptic_Longitude'].tolist()
+        if 'Ecliptic_Latitude' in planetary_data.columns:
+            latitudes = planetary_data['Ecliptic_Latitude'].tolist()
+        else:
+            latitudes = [None] * len(planets)
+        
+        angle_names = set()
+        if angles:
+            angle_names = set(angles)
+            planets.extend(angles.keys())
+            positions.extend(angles.values())
+            latitudes.extend([None] * len(angles))
         
         # Calculate aspects between all planet pairs
         for i in range(len(planets)):
             for j in range(i + 1, len(planets)):
+                if planets[i] in angle_names and planets[j] in angle_names:
+                    continue
+                
                 planet1 = planets[i]
                 planet2 = planets[j]
                 pos1 = positions[i]
                 pos2 = positions[j]
                 
-                aspects = self.find_aspects_between_planets(planet1, pos1, planet2, pos2)
+                aspects = self.find_aspects_between_planets(planet1, pos1, planet2, pos2,
+                                                            latitudes[i], latitudes[j])
+                
+                # The Sun-Moon aspect doubles as the lunation phase
+                if self.tag_lunation and {planet1, planet2} == {'Sun', 'Moon'}:
+                    sun_pos, moon_pos = (pos1, pos2) if planet1 == 'Sun' else (pos2, pos1)
+                    phase = self.zodiac_calculator.get_lunar_phase(sun_pos, moon_pos)
+                    for aspect in aspects:
+                        aspect['description'] = f"{planet1} {aspect['aspect']} {planet2} ({phase})"
+                
                 aspects_list.extend(aspects)
         
         return pd.DataFrame(aspects_list)
     
+    def calculate_declination_grid(self,
+                                   planetary_data: pd.DataFrame,
+                                   orb: float = 1.0) -> pd.DataFrame:
+        """
+        Mark parallels and contraparallels of declination between all planets.
+        
+        Args:
+            planetary_data: DataFrame with planetary positions (must have a 'Dec' column)
+            orb: Maximum difference in declination in degrees
+            
+        Returns:
+            Symmetric DataFrame indexed by planet name on both axes, holding
+            'P' for a parallel, 'CP' for a contraparallel and '' otherwise
+        """
+        if 'Dec' not in planetary_data.columns:
+            raise ValueError("DataFrame must contain 'Dec' column")
+        
+        planets = planetary_data['Planet'].tolist()
+        declinations = planetary_data['Dec'].tolist()
+        
+        grid = []
+        for i, dec1 in enumerate(declinations):
+            row = []
+            for j, dec2 in enumerate(declinations):
+                if i == j:
+                    row.append('')
+                elif abs(dec1 - dec2) <= orb:
+                    row.append('P')
+                elif abs(dec1 + dec2) <= orb:
+                    row.append('CP')
+                else:
+                    row.append('')
+            grid.append(row)
+        
+        return pd.DataFrame(grid, index=planets, columns=planets)
+    
+    def calculate_synastry_aspects(self,
+                                   chart1_data: pd.DataFrame,
+                                   chart2_data: pd.DataFrame) -> pd.DataFrame:
+        """
+        Calculate the aspects between the planets of two charts.
+        
+        Args:
+            chart1_data: DataFrame with planetary positions of the first chart
+            chart2_data: DataFrame with planetary positions of the second chart
+            
+        Returns:
+            DataFrame with cross-chart aspects (planet1 from the first chart,
+            planet2 from the second)
+        """
+        for data in (chart1_data, chart2_data):
+            if 'Ecliptic_Longitude' not in data.columns:
+                raise ValueError("DataFrame must contain 'Ecliptic_Longitude' column")
+        
+        aspects_list = []
+        for _, body1 in chart1_data.iterrows():
+            for _, body2 in chart2_data.iterrows():
+                aspects_list.extend(self.find_aspects_between_planets(
+                    body1['Planet'], body1['Ecliptic_Longitude'],
+                    body2['Planet'], body2['Ecliptic_Longitude']
+                ))
+        
+        return pd.DataFrame(aspects_list)
+    
+    def calculate_synastry_grid(self,
+                                chart1_data: pd.DataFrame,
+                                chart2_data: pd.DataFrame) -> pd.DataFrame:
+        """
+        Arrange the aspects between two charts as a planet-by-planet grid.
+        
+        Args:
+            chart1_data: DataFrame with planetary positions of the first chart
+            chart2_data: DataFrame with planetary positions of the second chart
+            
+        Returns:
+            DataFrame indexed by the first chart's planets with a column for each
+            of the second chart's planets, holding the name of the closest
+            aspect between them or None
+        """
+        for data in (chart1_data, chart2_data):
+            if 'Ecliptic_Longitude' not in data.columns:
+                raise ValueError("DataFrame must contain 'Ecliptic_Longitude' column")
+        
+        grid = []
+        for _, body1 in chart1_data.iterrows():
+            row = []
+            for _, body2 in chart2_data.iterrows():
+                aspects = self.find_aspects_between_planets(
+                    body1['Planet'], body1['Ecliptic_Longitude'],
+                    body2['Planet'], body2['Ecliptic_Longitude']
+                )
+                closest = min(aspects, key=lambda aspect: aspect['orb_difference'], default=None)
+                row.append(closest['aspect'] if closest else None)
+            grid.append(row)
+        
+        return pd.DataFrame(grid,
+                            index=chart1_data['Planet'].tolist(),
+                            columns=chart2_data['Planet'].tolist())
+    
+    def calculate_chart_compatibility(self,
+                                      chart1_data: pd.DataFrame,
+                                      chart2_data: pd.DataFrame) -> float:
+        """
+        Score the compatibility of two charts from their synastry aspects.
+        
+        Harmonious aspects between the luminaries and personal planets raise
+        the score and challenging ones lower it, each weighted by exactness.
+        
+        Args:
+            chart1_data: DataFrame with planetary positions of the first chart
+            chart2_data: DataFrame with planetary positions of the second chart
+            
+        Returns:
+            Compatibility percentage (0-100, 50 is neutral)
+        """
+        chart1_personal = chart1_data[chart1_data['Planet'].isin(self.COMPATIBILITY_PLANETS)]
+        chart2_personal = chart2_data[chart2_data['Planet'].isin(self.COMPATIBILITY_PLANETS)]
+        aspects_df = self.calculate_synastry_aspects(chart1_personal, chart2_personal)
+        
+        if aspects_df.empty:
+            return 50.0
+        
+        nature_weights = {'Harmonious': 1.0, 'Neutral': 0.5, 'Challenging': -1.0}
+        weights = aspects_df['exactness'] / 100
+        valence = aspects_df['nature'].map(nature_weights).fillna(0.0)
+        
+        total = weights.sum()
+        if total == 0:
+            return 50.0
+        
+        return float(50 + 50 * (weights * valence).sum() / total)
+    
     def get_aspect_interpretation(self, aspect: str, planet1: str, planet2: str) -> str:
         """
         Get a basic interpretation of an aspect between two planets.
@@ -228,6 +712,34 @@ class AspectsCalculator:
         
         return sorted_aspects.head(limit)
     
+    def sort_aspects(self, aspects_df: pd.DataFrame, by: str = 'strength') -> pd.DataFrame:
+        """
+        Sort aspects for display.
+        
+        Args:
+            aspects_df: DataFrame with aspects
+            by: Sort key - 'strength' (most exact first), 'orb' (tightest first),
+                'angle' (aspect angle ascending) or 'planet' (alphabetical by planets)
+            
+        Returns:
+            Sorted DataFrame (ties keep their original order)
+        """
+        sort_keys = {
+            'strength': (['exactness'], [False]),
+            'orb': (['orb_difference'], [True]),
+            'angle': (['degrees'], [True]),
+            'planet': (['planet1', 'planet2'], [True, True])
+        }
+        
+        if by not in sort_keys:
+            raise ValueError(f"Unknown sort key '{by}', expected one of {list(sort_keys)}")
+        
+        if aspects_df.empty:
+            return aspects_df
+        
+        columns, ascending = sort_keys[by]
+        return aspects_df.sort_values(columns, ascending=ascending, kind='mergesort')
+    
     def get_aspects_by_nature(self, aspects_df: pd.DataFrame, nature: str) -> pd.DataFrame:
         """
         Filter aspects by their nature (Harmonious, Challenging, etc.).
@@ -263,6 +775,103 @@ class AspectsCalculator:
             (aspects_df['planet2'] == planet)
         ]
     
+    def get_closest_aspect(self,
+                           aspects_df: pd.DataFrame,
+                           planet1: str,
+                           planet2: str) -> Optional[pd.Series]:
+        """
+        Get the tightest aspect between two specific planets.
+        
+        Args:
+            aspects_df: DataFrame with aspects
+            planet1: First planet name
+            planet2: Second planet name
+            
+        Returns:
+            Row of the aspect with the smallest orb, or None if the planets
+            form no aspect
+        """
+        if aspects_df.empty:
+            return None
+        
+        pair_aspects = aspects_df[
+            ((aspects_df['planet1'] == planet1) & (aspects_df['planet2'] == planet2)) |
+            ((aspects_df['planet1'] == planet2) & (aspects_df['planet2'] == planet1))
+        ]
+        
+        if pair_aspects.empty:
+            return None
+        
+        return pair_aspects.loc[pair_aspects['orb_difference'].idxmin()]
+    
+    def get_aspects_to_ruler(self, aspects_df: pd.DataFrame, ascendant: float) -> pd.DataFrame:
+        """
+        Get all aspects involving the chart ruler.
+        
+        Args:
+            aspects_df: DataFrame with aspects
+            ascendant: Ascendant position in degrees
+            
+        Returns:
+            DataFrame with aspects involving the ruler of the Ascendant's sign
+        """
+        ruler = self.zodiac_calculator.get_chart_ruler(ascendant)
+        
+        return self.get_planet_aspects(aspects_df, ruler)
+    
+    def calculate_aspect_valence(self, aspects_df: pd.DataFrame) -> float:
+        """
+        Net the strength of harmonious aspects against challenging ones.
+        
+        Each aspect counts by its exactness (0-1); minor aspects are scaled
+        by MINOR_VALENCE_WEIGHT and neutral aspects do not count.
+        
+        Args:
+            aspects_df: DataFrame with aspects
+            
+        Returns:
+            Net valence (positive when harmonious aspects dominate)
+        """
+        if aspects_df.empty:
+            return 0.0
+        
+        weights = aspects_df['exactness'] / 100
+        valence = aspects_df['nature'].map({'Harmonious': 1.0, 'Challenging': -1.0}).fillna(0.0)
+        is_minor = aspects_df['aspect'].isin(list(self.MINOR_ASPECTS))
+        scale = is_minor.map({True: self.MINOR_VALENCE_WEIGHT, False: 1.0})
+        
+        return float((weights * valence * scale).sum())
+    
+    def calculate_aspect_density(self,
+                                 aspects_df: pd.DataFrame,
+                                 planet_count: int,
+                                 weighted: bool = False) -> float:
+        """
+        Measure how densely aspected a chart is.
+        
+        Args:
+            aspects_df: DataFrame with aspects
+            planet_count: Number of planets the aspects were calculated between
+            weighted: Whether each pair counts by the exactness of its tightest
+                     aspect rather than as a whole
+            
+        Returns:
+            Aspected pairs divided by possible pairs (0-1)
+        """
+        possible_pairs = planet_count * (planet_count - 1) / 2
+        if possible_pairs <= 0 or aspects_df.empty:
+            return 0.0
+        
+        pair_exactness = {}
+        for _, aspect in aspects_df.iterrows():
+            pair = frozenset((aspect['planet1'], aspect['planet2']))
+            pair_exactness[pair] = max(pair_exactness.get(pair, 0), aspect['exactness'])
+        
+        if weighted:
+            return sum(pair_exactness.values()) / 100 / possible_pairs
+        
+        return len(pair_exactness) / possible_pairs
+    
     def calculate_aspect_patterns(self, aspects_df: pd.DataFrame) -> List[Dict[str, any]]:
         """
         Identify common aspect patterns (Grand Trine, T-Square, etc.).
@@ -300,13 +909,7 @@ class AspectsCalculator:
                 })
         
         # Look for T-Square (2 squares and 1 opposition)
-        if len(squares) >= 2 and len(oppositions) >= 1:
-            # Simplified pattern detection
-            patterns.append({
-                'pattern': 'T-Square',
-                'planets': ['Unknown'],  # Would need more complex logic
-                'description': 'A challenging pattern requiring action'
-            })
+        patterns.extend(self._find_t_squares(aspects_df))
         
         # Look for Grand Cross (4 squares and 2 oppositions)
         if len(squares) >= 4 and len(oppositions) >= 2:
@@ -316,8 +919,220 @@ class AspectsCalculator:
                 'description': 'A highly dynamic and challenging pattern'
             })
         
+        patterns.extend(self._find_cradles(aspects_df))
+        
         return patterns
     
+    def _find_t_squares(self, aspects_df: pd.DataFrame) -> List[Dict[str, any]]:
+        """
+        Find T-Squares (an opposition whose ends are both squared by an apex).
+        
+        Args:
+            aspects_df: DataFrame with aspects
+            
+        Returns:
+            List of dictionaries with pattern information, including the apex
+        """
+        squares = {frozenset((row['planet1'], row['planet2']))
+                   for _, row in aspects_df[aspects_df['aspect'] == 'Square'].iterrows()}
+        oppositions = aspects_df[aspects_df['aspect'] == 'Opposition']
+        planets = set().union(*squares) if squares else set()
+        
+        t_squares = []
+        for _, opposition in oppositions.iterrows():
+            end1, end2 = opposition['planet1'], opposition['planet2']
+            for apex in sorted(planets - {end1, end2}):
+                if frozenset((end1, apex)) in squares and frozenset((end2, apex)) in squares:
+                    t_squares.append({
+                        'pattern': 'T-Square',
+                        'planets': [end1, end2, apex],
+                        'apex': apex,
+                        'description': 'A challenging pattern requiring action'
+                    })
+        
+        return t_squares
+    
+    def _find_cradles(self, aspects_df: pd.DataFrame) -> List[Dict[str, any]]:
+        """
+        Find Cradle patterns (three chained sextiles spanned by an opposition).
+        
+        Args:
+            aspects_df: DataFrame with aspects
+            
+        Returns:
+            List of dictionaries with pattern information
+        """
+        sextiles = {frozenset((row['planet1'], row['planet2']))
+                    for _, row in aspects_df[aspects_df['aspect'] == 'Sextile'].iterrows()}
+        oppositions = aspects_df[aspects_df['aspect'] == 'Opposition']
+        planets = set().union(*sextiles) if sextiles else set()
+        
+        cradles = []
+        for _, opposition in oppositions.iterrows():
+            start, end = opposition['planet1'], opposition['planet2']
+            for middle1 in sorted(planets - {start, end}):
+                if frozenset((start, middle1)) not in sextiles:
+                    continue
+                for middle2 in sorted(planets - {start, end, middle1}):
+                    if (frozenset((middle1, middle2)) in sextiles and
+                            frozenset((middle2, end)) in sextiles):
+                        cradles.append({
+                            'pattern': 'Cradle',
+                            'planets': [start, middle1, middle2, end],
+                            'description': 'A supportive chain of sextiles bridging an opposition'
+                        })
+        
+        return cradles
+    
+    def find_incomplete_trines(self,
+                               aspects_df: pd.DataFrame,
+                               planetary_data: pd.DataFrame,
+                               orb: float = 8.0,
+                               angles: Optional[Dict[str, float]] = None) -> List[Dict[str, any]]:
+        """
+        Find the empty vertex that would complete each trine into a Grand Trine.
+        
+        Every trine is checked on its own: one is reported whenever nothing
+        stands at its third vertex, without requiring a second trine to be
+        present already.
+        
+        Args:
+            aspects_df: DataFrame with aspects
+            planetary_data: DataFrame with planetary positions
+            orb: Maximum distance in degrees for a planet to fill the vertex
+            angles: Chart angles included when the aspects were calculated
+                   (optional). Trines to points with no known position are skipped.
+            
+        Returns:
+            List of dictionaries with the trine's 'planets' and the
+            'completing_degree' where no planet currently stands
+        """
+        if 'Ecliptic_Longitude' not in planetary_data.columns:
+            raise ValueError("DataFrame must contain 'Ecliptic_Longitude' column")
+        if aspects_df.empty:
+            return []
+        
+        positions = dict(zip(planetary_data['Planet'], planetary_data['Ecliptic_Longitude']))
+        if angles:
+            positions.update(angles)
+        
+        incomplete = []
+        for _, trine in aspects_df[aspects_df['aspect'] == 'Trine'].iterrows():
+            if trine['planet1'] not in positions or trine['planet2'] not in positions:
+                continue
+            
+            pos1 = positions[trine['planet1']]
+            pos2 = positions[trine['planet2']]
+            
+            # The third vertex faces the midpoint of the shorter arc
+            midpoint = (pos1 + ((pos2 - pos1 + 180) % 360 - 180) / 2) % 360
+            completing_degree = (midpoint + 180) % 360
+            
+            if not any(angular_separation(position, completing_degree) <= orb
+                       for position in positions.values()):
+                incomplete.append({
+                    'planets': [trine['planet1'], trine['planet2']],
+                    'completing_degree': completing_degree
+                })
+        
+        return incomplete
+    
+    def calculate_dial_position(self, longitude: float, dial: float = 90.0) -> float:
+        """
+        Project a longitude onto a cosmobiology dial.
+        
+        On a 90° dial, conjunctions, squares and oppositions all coincide.
+        
+        Args:
+            longitude: Ecliptic longitude in degrees
+            dial: Size of the dial in degrees (e.g. 90, 45, 360)
+            
+        Returns:
+            Position on the dial in degrees (0 to dial)
+        """
+        return longitude % dial
+    
+    def find_dial_contacts(self,
+                           planetary_data: pd.DataFrame,
+                           dial: float = 90.0,
+                           orb: float = 1.5) -> List[Tuple[str, str]]:
+        """
+        Find pairs of planets that coincide on a cosmobiology dial.
+        
+        Args:
+            planetary_data: DataFrame with planetary positions
+            dial: Size of the dial in degrees (e.g. 90, 45, 360)
+            orb: Maximum distance on the dial in degrees
+            
+        Returns:
+            List of (planet1, planet2) tuples
+        """
+        if 'Ecliptic_Longitude' not in planetary_data.columns:
+            raise ValueError("DataFrame must contain 'Ecliptic_Longitude' column")
+        
+        planets = planetary_data['Planet'].tolist()
+        dial_positions = [self.calculate_dial_position(longitude, dial)
+                          for longitude in planetary_data['Ecliptic_Longitude']]
+        
+        contacts = []
+        for i in range(len(planets)):
+            for j in range(i + 1, len(planets)):
+                distance = abs(dial_positions[i] - dial_positions[j])
+                if min(distance, dial - distance) <= orb:
+                    contacts.append((planets[i], planets[j]))
+        
+        return contacts
+    
+    def find_degree_clusters(self,
+                             planetary_data: pd.DataFrame,
+                             max_spread: float = 10.0,
+                             min_planets: int = 3) -> List[Dict[str, any]]:
+        """
+        Identify tight clusters of planets regardless of sign boundaries.
+        
+        Args:
+            planetary_data: DataFrame with planetary positions
+            max_spread: Maximum arc in degrees covered by a cluster
+            min_planets: Minimum number of planets to report a cluster
+            
+        Returns:
+            List of dictionaries with pattern information
+        """
+        if 'Ecliptic_Longitude' not in planetary_data.columns:
+            raise ValueError("DataFrame must contain 'Ecliptic_Longitude' column")
+        
+        bodies = sorted(
+            zip(planetary_data['Ecliptic_Longitude'] % 360, planetary_data['Planet']),
+            key=lambda body: body[0]
+        )
+        if len(bodies) < min_planets:
+            return []
+        
+        # Start the walk after the widest gap so no cluster is split at 0° Aries
+        gaps = [(bodies[(i + 1) % len(bodies)][0] - bodies[i][0]) % 360
+                for i in range(len(bodies))]
+        start = (gaps.index(max(gaps)) + 1) % len(bodies)
+        bodies = bodies[start:] + bodies[:start]
+        
+        groups = [[bodies[0]]]
+        for body in bodies[1:]:
+            if (body[0] - groups[-1][0][0]) % 360 <= max_spread:
+                groups[-1].append(body)
+            else:
+                groups.append([body])
+        
+        clusters = []
+        for group in groups:
+            if len(group) >= min_planets:
+                spread = (group[-1][0] - group[0][0]) % 360
+                clusters.append({
+                    'pattern': 'Cluster',
+                    'planets': [planet for _, planet in group],
+                    'description': f'A tight concentration of energy spanning {spread:.1f}°'
+                })
+        
+        return clusters
+    
     def format_aspect_string(self, aspect_row: pd.Series) -> str:
         """
         Format an aspect as a readable string.
@@ -355,4 +1170,34 @@ class AspectsCalculator:
             }
         }
         
-        return summary
\ No newline at end of file
+        return summary
+    
+    def export_aspects_json(self, aspects_df: pd.DataFrame) -> str:
+        """
+        Serialize aspects to JSON with display metadata.
+        
+        Args:
+            aspects_df: DataFrame with aspects
+            
+        Returns:
+            JSON array with one object per aspect
+        """
+        records = []
+        
+        for _, aspect in aspects_df.iterrows():
+            records.append({
+                'planet1': aspect['planet1'],
+                'planet2': aspect['planet2'],
+                'aspect': aspect['aspect'],
+                'symbol': aspect['symbol'],
+                'degrees': float(aspect['degrees']),
+                'orb': float(aspect['orb_difference']),
+                'exactness': float(aspect['exactness']),
+                'applying': aspect['applying'],
+                'nature': aspect['nature'],
+                'interpretation': self.get_aspect_interpretation(
+                    aspect['aspect'], aspect['planet1'], aspect['planet2']
+                )
+            })
+        
+        return json.dumps(records, ensure_ascii=False)
\ No newline at end of file
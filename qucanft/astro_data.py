@@ -5,7 +5,7 @@ This module provides functionality to fetch planetary positioning and celestial
 from public astronomical databases such as JPL Horizons.
 """
 
-from typing import Dict, List, Optional, Tuple, Union
+from typing import Callable, Dict, List, Optional, Tuple, Union
 from datetime import datetime, timezone
 import pandas as pd
 import numpy as np
@@ -15,6 +15,9 @@ from astropy.coordinates import EarthLocation
 from astropy import units as u
 import pytz
 
+from .coordinates import location_distance
+from .zodiac import ZodiacCalculator
+
 
 class AstroDataFetcher:
     """
@@ -38,9 +41,58 @@ class AstroDataFetcher:
         'Pluto': 999
     }
     
-    def __init__(self):
-        """Initialize the AstroDataFetcher."""
+    # Kilometers per astronomical unit
+    AU_KM = 149597870.7
+    
+    # Length of the tropical year in days
+    TROPICAL_YEAR = 365.242189
+    
+    def __init__(self, zodiac_calculator: Optional[ZodiacCalculator] = None):
+        """
+        Initialize the AstroDataFetcher.
+        
+        Args:
+            zodiac_calculator: Calculator used to convert fetched coordinates to
+                              ecliptic longitudes. If None, a tropical
+                              ZodiacCalculator is used.
+        """
         self.data_cache = {}
+        if zodiac_calculator is None:
+            zodiac_calculator = ZodiacCalculator()
+        self.zodiac_calculator = zodiac_calculator
+    
+    def parse_date(self, date: Union[str, datetime]) -> Time:
+        """
+        Convert a date to an astropy Time in UTC.
+        
+        ISO 8601 strings may carry a UTC offset (e.g. "1990-06-15T14:30:00-05:00"
+        or a trailing "Z"); the offset is applied before the query. Naive
+        datetimes and strings without an offset are taken as UTC.
+        
+        Args:
+            date: ISO format string, datetime object, or any value astropy's
+                 Time accepts
+            
+        Returns:
+            Time object for the instant
+            
+        Raises:
+            ValueError: If the date cannot be parsed
+        """
+        if isinstance(date, str):
+            try:
+                date = datetime.fromisoformat(date.strip().replace('Z', '+00:00'))
+            except ValueError:
+                # Fall back to the formats astropy understands natively
+                try:
+                    return Time(date)
+                except ValueError as e:
+                    raise ValueError(f"Could not parse date '{date}': {e}")
+        
+        if isinstance(date, datetime) and date.tzinfo is not None:
+            date = date.astimezone(timezone.utc).replace(tzinfo=None)
+        
+        return Time(date)
     
     def get_planet_positions(self, 
                            date: Union[str, datetime],
@@ -56,7 +108,9 @@ class AstroDataFetcher:
             planets: List of planet names to query. If None, queries all major planets.
             
         Returns:
-            DataFrame with planetary positions including RA, Dec, and other ephemeris data
+            DataFrame with planetary positions including astrometric RA and Dec,
+            apparent RA_app and Dec_app (true equator and equinox of date), and
+            other ephemeris data
             
         Example:
             >>> fetcher = AstroDataFetcher()
@@ -70,12 +124,7 @@ class AstroDataFetcher:
             planets = list(self.PLANETS.keys())
         
         # Convert date to proper format
-        if isinstance(date, str):
-            query_date = Time(date)
-        elif isinstance(date, datetime):
-            query_date = Time(date)
-        else:
-            query_date = Time(date)
+        query_date = self.parse_date(date)
         
         # Setup location
         if location is None:
@@ -119,6 +168,8 @@ class AstroDataFetcher:
                     'Date': query_date.iso,
                     'RA': ephemeris['RA'][0],  # Right Ascension
                     'Dec': ephemeris['DEC'][0],  # Declination
+                    'RA_app': ephemeris['RA_app'][0] if 'RA_app' in ephemeris.colnames else np.nan,
+                    'Dec_app': ephemeris['DEC_app'][0] if 'DEC_app' in ephemeris.colnames else np.nan,
                     'Distance_AU': ephemeris['delta'][0],  # Distance in AU
                     'Magnitude': ephemeris['V'][0] if 'V' in ephemeris.colnames else np.nan,
                     'Elongation': ephemeris['elong'][0] if 'elong' in ephemeris.colnames else np.nan,
@@ -133,6 +184,83 @@ class AstroDataFetcher:
         
         return pd.DataFrame(results)
     
+    def get_solar_longitudes(self,
+                             date: Union[str, datetime],
+                             location: Optional[Union[str, Dict[str, float]]] = None) -> Dict[str, float]:
+        """
+        Get the Sun's geometric and apparent ecliptic longitude at an instant.
+        
+        Both longitudes are referred to the true equinox of date. The apparent
+        one also includes aberration (about -20.5"), which matters for
+        sub-minute sunrise, sunset and ingress timing.
+        
+        Args:
+            date: Date for the query (ISO format string or datetime object)
+            location: Location specification
+            
+        Returns:
+            Dictionary with 'geometric' and 'apparent' longitudes in degrees
+            
+        Raises:
+            RuntimeError: If the Sun's apparent position could not be fetched
+        """
+        sun = self.get_planet_positions(date, location, ['Sun'])
+        if sun.empty:
+            raise RuntimeError("Could not fetch the Sun's position")
+        
+        row = sun.iloc[0]
+        if pd.isna(row['RA_app']) or pd.isna(row['Dec_app']):
+            raise RuntimeError("Horizons returned no apparent position for the Sun")
+        
+        geometric, _ = self.zodiac_calculator.ra_dec_to_ecliptic(row['RA'], row['Dec'],
+                                                                 obstime=row['Date'])
+        apparent, _ = self.zodiac_calculator.ra_dec_to_ecliptic(row['RA_app'], row['Dec_app'],
+                                                                obstime=row['Date'], apparent=True)
+        
+        return {'geometric': geometric, 'apparent': apparent}
+    
+    def get_progressed_lunar_phase(self,
+                                   birth_date: Union[str, datetime],
+                                   target_date: Union[str, datetime],
+                                   location: Optional[Union[str, Dict[str, float]]] = None) -> Dict[str, any]:
+        """
+        Get the secondary-progressed lunar phase for a date in a native's life.
+        
+        Secondary progressions take the sky one day after birth for each year
+        of life, so the progressed Sun and Moon are fetched for the birth time
+        plus the native's age in years counted as days. The progressed
+        lunation cycle lasts about 29.5 years, moving through a phase roughly
+        every 3.7 years.
+        
+        Args:
+            birth_date: Birth date and time (ISO format string or datetime object)
+            target_date: Date to progress the chart to
+            location: Location specification
+            
+        Returns:
+            Dictionary with the 'phase' name, the Sun-Moon 'angle' in degrees
+            (0-360) and the 'progressed_date' the positions were fetched for
+            
+        Raises:
+            RuntimeError: If the progressed Sun or Moon could not be fetched
+        """
+        birth_time = self.parse_date(birth_date)
+        age_in_years = (self.parse_date(target_date).jd - birth_time.jd) / self.TROPICAL_YEAR
+        progressed_time = Time(birth_time.jd + age_in_years, format='jd', scale='utc')
+        
+        positions = self.get_planet_positions(progressed_time.datetime, location, ['Sun', 'Moon'])
+        longitudes = {}
+        for _, row in positions.iterrows():
+            longitudes[row['Planet']], _ = self.zodiac_calculator.ra_dec_to_ecliptic(row['RA'], row['Dec'])
+        if 'Sun' not in longitudes or 'Moon' not in longitudes:
+            raise RuntimeError("Could not fetch the progressed Sun and Moon")
+        
+        return {
+            'phase': self.zodiac_calculator.get_lunar_phase(longitudes['Sun'], longitudes['Moon']),
+            'angle': (longitudes['Moon'] - longitudes['Sun']) % 360,
+            'progressed_date': progressed_time.iso
+        }
+    
     def get_ephemeris_range(self,
                            start_date: Union[str, datetime],
                            end_date: Union[str, datetime],
@@ -165,8 +293,8 @@ class AstroDataFetcher:
             raise ValueError(f"Unknown planet: {planet}")
         
         # Convert dates
-        start_time = Time(start_date)
-        end_time = Time(end_date)
+        start_time = self.parse_date(start_date)
+        end_time = self.parse_date(end_date)
         
         # Setup location
         if location is None:
@@ -209,6 +337,415 @@ class AstroDataFetcher:
         except Exception as e:
             raise RuntimeError(f"Error fetching ephemeris data for {planet}: {e}")
     
+    def find_lunar_apsides(self,
+                           start_date: Union[str, datetime],
+                           end_date: Union[str, datetime],
+                           step: str = '1h',
+                           location: Optional[Union[str, Dict[str, float]]] = None) -> Dict[str, Dict[str, any]]:
+        """
+        Find the Moon's closest (perigee) and farthest (apogee) points in a range.
+        
+        Args:
+            start_date: Start date for the range
+            end_date: End date for the range
+            step: Time step for scanning the Moon's distance
+            location: Location specification
+            
+        Returns:
+            Dictionary with 'perigee' and 'apogee' entries, each holding the
+            'datetime', 'jd', 'distance_au' and 'distance_km' of the extreme
+            
+        Example:
+            >>> fetcher = AstroDataFetcher()
+            >>> apsides = fetcher.find_lunar_apsides("2023-01-01", "2023-01-31")
+            >>> apsides['perigee']['distance_km']
+        """
+        moon_data = self.get_ephemeris_range(start_date, end_date, step, 'Moon', location)
+        if moon_data.empty:
+            raise ValueError("No lunar ephemeris data in the requested range")
+        
+        apsides = {}
+        for name, index in (('perigee', moon_data['delta'].idxmin()),
+                            ('apogee', moon_data['delta'].idxmax())):
+            row = moon_data.loc[index]
+            apsides[name] = {
+                'datetime': row['datetime_str'],
+                'jd': row['datetime_jd'],
+                'distance_au': row['delta'],
+                'distance_km': row['delta'] * self.AU_KM
+            }
+        
+        return apsides
+    
+    def get_planet_speeds(self,
+                          date: Union[str, datetime],
+                          planets: Optional[List[str]] = None,
+                          location: Optional[Union[str, Dict[str, float]]] = None,
+                          window_minutes: int = 60) -> Dict[str, float]:
+        """
+        Get each planet's daily motion in ecliptic longitude at an instant.
+        
+        Each planet's ecliptic longitude is sampled at the instant and again
+        window_minutes later, and the change is scaled to a full day.
+        
+        Args:
+            date: Date for the query (ISO format string or datetime object)
+            planets: List of planet names to check. If None, checks all major planets.
+            location: Location specification
+            window_minutes: Minutes between the two longitude samples
+            
+        Returns:
+            Dictionary mapping planet names to degrees per day, negative for
+            retrograde motion, in the order they were checked
+            
+        Example:
+            >>> fetcher = AstroDataFetcher()
+            >>> fetcher.get_planet_speeds("2023-01-01T00:00:00", planets=["Moon"])
+            {'Moon': 12.9...}
+        """
+        if planets is None:
+            planets = list(self.PLANETS.keys())
+        
+        start_time = self.parse_date(date)
+        end_time = Time(start_time.jd + window_minutes / 1440, format='jd')
+        
+        speeds = {}
+        for planet in planets:
+            ephemeris = self.get_ephemeris_range(start_time, end_time, f"{window_minutes}m",
+                                                 planet, location)
+            samples = self.zodiac_calculator.get_ephemeris_longitudes(ephemeris)
+            if len(samples) < 2:
+                raise RuntimeError(f"Not enough ephemeris samples to find the motion of {planet}")
+            
+            # Signed motion between the samples, taking the short way round
+            motion = (samples[-1][1] - samples[0][1] + 180) % 360 - 180
+            speeds[planet] = motion / (samples[-1][0] - samples[0][0])
+        
+        return speeds
+    
+    def get_retrograde_planets(self,
+                               date: Union[str, datetime],
+                               planets: Optional[List[str]] = None,
+                               location: Optional[Union[str, Dict[str, float]]] = None,
+                               window_minutes: int = 60) -> List[str]:
+        """
+        List the planets moving retrograde at an instant.
+        
+        A planet is retrograde if its longitude went backwards over the
+        window used by get_planet_speeds.
+        
+        Args:
+            date: Date for the query (ISO format string or datetime object)
+            planets: List of planet names to check. If None, checks all major planets.
+            location: Location specification
+            window_minutes: Minutes between the two longitude samples
+            
+        Returns:
+            Names of the retrograde planets, in the order they were checked
+            
+        Example:
+            >>> fetcher = AstroDataFetcher()
+            >>> fetcher.get_retrograde_planets("2023-04-25T00:00:00")
+            ['Mercury']
+        """
+        speeds = self.get_planet_speeds(date, planets, location, window_minutes)
+        
+        return [planet for planet, speed in speeds.items() if speed < 0]
+    
+    def find_conjunction(self,
+                         planet1: str,
+                         planet2: str,
+                         start_date: Union[str, datetime],
+                         end_date: Union[str, datetime],
+                         step: str = '1d',
+                         refine_step: Optional[str] = '1m',
+                         location: Optional[Union[str, Dict[str, float]]] = None) -> Dict[str, any]:
+        """
+        Find the exact time of the first conjunction of two planets in a range.
+        
+        The difference in ecliptic longitude is scanned at step until it
+        changes sign; that interval is scanned again at refine_step and the
+        zero crossing is linearly interpolated.
+        
+        Args:
+            planet1: Name of the first planet
+            planet2: Name of the second planet
+            start_date: Start date for the search
+            end_date: End date for the search
+            step: Time step for the coarse scan (e.g. '1d')
+            refine_step: Time step for rescanning the crossing (None to skip)
+            location: Location specification
+            
+        Returns:
+            Dictionary with the 'jd', UTC 'datetime' and ecliptic 'longitude'
+            of the conjunction
+            
+        Raises:
+            ValueError: If the planets do not meet within the range
+            
+        Example:
+            >>> fetcher = AstroDataFetcher()
+            >>> fetcher.find_conjunction("Jupiter", "Saturn", "2020-12-01", "2021-01-10")
+        """
+        def sample_separation(start, end, sample_step):
+            samples1 = self._get_longitude_samples(planet1, start, end, sample_step, location)
+            samples2 = self._get_longitude_samples(planet2, start, end, sample_step, location)
+            return [
+                (julian_day, (lon1 - lon2 + 180) % 360 - 180, lon1)
+                for (julian_day, lon1), (_, lon2) in zip(samples1, samples2)
+            ]
+        
+        crossings = self._find_crossings(sample_separation, self.parse_date(start_date),
+                                         self.parse_date(end_date), step, refine_step)
+        if not crossings:
+            raise ValueError(f"No {planet1}-{planet2} conjunction between {start_date} and {end_date}")
+        
+        julian_day, longitude, _ = crossings[0]
+        
+        return {
+            'jd': julian_day,
+            'datetime': Time(julian_day, format='jd').iso,
+            'longitude': longitude
+        }
+    
+    def find_transits_to_point(self,
+                               planet: str,
+                               target_longitude: float,
+                               aspect_degrees: float,
+                               start_date: Union[str, datetime],
+                               end_date: Union[str, datetime],
+                               step: str = '1d',
+                               refine_step: Optional[str] = '1m',
+                               location: Optional[Union[str, Dict[str, float]]] = None) -> List[Dict[str, any]]:
+        """
+        Find when a transiting planet perfects an aspect to a fixed degree.
+        
+        The fixed degree is typically a natal angle such as the MC or
+        Ascendant. Each degree crossing is found like find_conjunction, so a
+        retrograde planet can perfect the same aspect up to three times.
+        
+        Args:
+            planet: Name of the transiting planet
+            target_longitude: Fixed ecliptic longitude in degrees (e.g. the natal MC)
+            aspect_degrees: Aspect angle in degrees (0 for a conjunction, 90 for a square)
+            start_date: Start date for the search
+            end_date: End date for the search
+            step: Time step for the coarse scan (e.g. '1d')
+            refine_step: Time step for rescanning each crossing (None to skip)
+            location: Location specification
+            
+        Returns:
+            List of dictionaries in time order with the 'jd', UTC 'datetime',
+            ecliptic 'longitude' reached and 'direction' of motion for each
+            perfection (empty if there is none in the range)
+        """
+        start_time = self.parse_date(start_date)
+        end_time = self.parse_date(end_date)
+        
+        # Both sides of the target unless the aspect is a conjunction or opposition
+        points = sorted({(target_longitude + aspect_degrees) % 360,
+                         (target_longitude - aspect_degrees) % 360})
+        
+        # Cache the coarse scan so it is fetched once for all aspect points
+        fetched = {}
+        def sample_longitudes(start, end, sample_step):
+            key = (start.jd, end.jd, sample_step)
+            if key not in fetched:
+                fetched[key] = self._get_longitude_samples(planet, start, end, sample_step, location)
+            return fetched[key]
+        
+        transits = []
+        for point in points:
+            def sample_offset(start, end, sample_step, point=point):
+                return [
+                    (julian_day, (longitude - point + 180) % 360 - 180, longitude)
+                    for julian_day, longitude in sample_longitudes(start, end, sample_step)
+                ]
+            
+            for julian_day, longitude, increasing in self._find_crossings(
+                    sample_offset, start_time, end_time, step, refine_step):
+                transits.append({
+                    'jd': julian_day,
+                    'datetime': Time(julian_day, format='jd').iso,
+                    'longitude': longitude,
+                    'direction': 'Direct' if increasing else 'Retrograde'
+                })
+        
+        return sorted(transits, key=lambda transit: transit['jd'])
+    
+    def find_equinoxes(self,
+                       year: int,
+                       step: str = '1d',
+                       refine_step: Optional[str] = '1m') -> Dict[str, Dict[str, any]]:
+        """
+        Find the March and September equinoxes of a year.
+        
+        These are the moments the Sun's apparent longitude of date reaches
+        0° and 180°.
+        
+        Args:
+            year: Calendar year
+            step: Time step for the coarse scan (e.g. '1d')
+            refine_step: Time step for rescanning each crossing (None to skip)
+            
+        Returns:
+            Dictionary with 'march' and 'september' entries, each holding the
+            'jd', UTC 'datetime' and 'longitude' of the crossing
+            
+        Example:
+            >>> fetcher = AstroDataFetcher()
+            >>> fetcher.find_equinoxes(2024)['march']['datetime']
+            '2024-03-20 03:06:...'
+        """
+        return self._find_solar_crossings(year, {'march': 0, 'september': 180}, step, refine_step)
+    
+    def find_solstices(self,
+                       year: int,
+                       step: str = '1d',
+                       refine_step: Optional[str] = '1m') -> Dict[str, Dict[str, any]]:
+        """
+        Find the June and December solstices of a year.
+        
+        These are the moments the Sun's apparent longitude of date reaches
+        90° and 270°.
+        
+        Args:
+            year: Calendar year
+            step: Time step for the coarse scan (e.g. '1d')
+            refine_step: Time step for rescanning each crossing (None to skip)
+            
+        Returns:
+            Dictionary with 'june' and 'december' entries, each holding the
+            'jd', UTC 'datetime' and 'longitude' of the crossing
+        """
+        return self._find_solar_crossings(year, {'june': 90, 'december': 270}, step, refine_step)
+    
+    def _find_solar_crossings(self,
+                              year: int,
+                              points: Dict[str, float],
+                              step: str,
+                              refine_step: Optional[str]) -> Dict[str, Dict[str, any]]:
+        """
+        Find when the Sun's apparent longitude reaches each point in a year.
+        
+        Args:
+            year: Calendar year
+            points: Mapping of names to ecliptic longitudes in degrees
+            step: Time step for the coarse scan
+            refine_step: Time step for rescanning each crossing (None to skip)
+            
+        Returns:
+            Dictionary mapping each name to its crossing
+            
+        Raises:
+            RuntimeError: If the Sun does not cross a point exactly once
+        """
+        start_time = Time(datetime(year, 1, 1))
+        end_time = Time(datetime(year + 1, 1, 1))
+        
+        # Cache the coarse scan so it is fetched once for all points
+        fetched = {}
+        def sample_longitudes(start, end, sample_step):
+            key = (start.jd, end.jd, sample_step)
+            if key not in fetched:
+                fetched[key] = self._get_longitude_samples('Sun', start, end, sample_step,
+                                                           apparent=True)
+            return fetched[key]
+        
+        crossings = {}
+        for name, point in points.items():
+            def sample_offset(start, end, sample_step, point=point):
+                return [
+                    (julian_day, (longitude - point + 180) % 360 - 180, longitude)
+                    for julian_day, longitude in sample_longitudes(start, end, sample_step)
+                ]
+            
+            found = self._find_crossings(sample_offset, start_time, end_time, step, refine_step)
+            if len(found) != 1:
+                raise RuntimeError(f"Expected one solar crossing of {point}° in {year}, found {len(found)}")
+            
+            julian_day, longitude, _ = found[0]
+            crossings[name] = {
+                'jd': julian_day,
+                'datetime': Time(julian_day, format='jd').iso,
+                'longitude': longitude
+            }
+        
+        return crossings
+    
+    def _get_longitude_samples(self,
+                               planet: str,
+                               start_time: Time,
+                               end_time: Time,
+                               step: str,
+                               location: Optional[Union[str, Dict[str, float]]] = None,
+                               apparent: bool = False) -> List[Tuple[float, float]]:
+        """
+        Fetch a planet's ecliptic longitude over a range.
+        
+        Args:
+            planet: Planet name to query
+            start_time: Start of the range
+            end_time: End of the range
+            step: Time step (e.g., '1d' for daily, '1h' for hourly)
+            location: Location specification
+            apparent: Whether to return apparent longitudes of date rather
+                     than J2000 ones
+            
+        Returns:
+            List of (julian_day, ecliptic_longitude) tuples in time order
+        """
+        ephemeris = self.get_ephemeris_range(start_time, end_time, step, planet, location)
+        
+        return self.zodiac_calculator.get_ephemeris_longitudes(ephemeris, apparent=apparent)
+    
+    def _find_crossings(self,
+                        sampler: Callable[[Time, Time, str], List[Tuple[float, float, float]]],
+                        start_time: Time,
+                        end_time: Time,
+                        step: str,
+                        refine_step: Optional[str] = None) -> List[Tuple[float, float, bool]]:
+        """
+        Find where a sampled angular difference passes through zero.
+        
+        Args:
+            sampler: Function of (start, end, step) returning (julian_day,
+                    difference, longitude) samples, with the difference in
+                    degrees wrapped to -180..180
+            start_time: Start of the range
+            end_time: End of the range
+            step: Time step for the coarse scan
+            refine_step: Time step for rescanning each crossing (None to skip)
+            
+        Returns:
+            List of (julian_day, longitude, increasing) tuples, where increasing
+            is True if the difference went from negative to positive
+        """
+        def crosses(before, after):
+            # A jump of 180° or more is the wrap-around, not a crossing
+            return (before[1] < 0 <= after[1] or before[1] > 0 >= after[1]) \
+                and abs(after[1] - before[1]) < 180
+        
+        crossings = []
+        samples = sampler(start_time, end_time, step)
+        for before, after in zip(samples, samples[1:]):
+            if not crosses(before, after):
+                continue
+            
+            if refine_step:
+                fine = sampler(Time(before[0], format='jd'), Time(after[0], format='jd'), refine_step)
+                for fine_before, fine_after in zip(fine, fine[1:]):
+                    if crosses(fine_before, fine_after):
+                        before, after = fine_before, fine_after
+                        break
+            
+            fraction = before[1] / (before[1] - after[1])
+            julian_day = before[0] + fraction * (after[0] - before[0])
+            longitude = (before[2] + fraction * ((after[2] - before[2] + 180) % 360 - 180)) % 360
+            crossings.append((julian_day, longitude, after[1] > before[1]))
+        
+        return crossings
+    
     def get_custom_query(self,
                         target_id: Union[str, int],
                         date: Union[str, datetime],
@@ -235,12 +772,7 @@ class AstroDataFetcher:
             ... )
         """
         # Convert date
-        if isinstance(date, str):
-            query_date = Time(date)
-        elif isinstance(date, datetime):
-            query_date = Time(date)
-        else:
-            query_date = Time(date)
+        query_date = self.parse_date(date)
         
         # Setup location
         if location is None:
@@ -307,6 +839,27 @@ class AstroDataFetcher:
             print(f"Warning: Location '{location_string}' not found, using Greenwich, UK")
             return {'lat': 51.4769, 'lon': 0.0, 'elevation': 0}
     
+    def get_location_distance(self,
+                              location1: Union[str, Dict[str, float]],
+                              location2: Union[str, Dict[str, float]]) -> float:
+        """
+        Calculate the distance between two locations.
+        
+        Args:
+            location1: Location as string (e.g., "New York, NY") or dict with
+                      'lat' and 'lon' keys
+            location2: Second location, in the same forms as location1
+            
+        Returns:
+            Great-circle distance in kilometers
+        """
+        if isinstance(location1, str):
+            location1 = self.get_location_from_string(location1)
+        if isinstance(location2, str):
+            location2 = self.get_location_from_string(location2)
+        
+        return location_distance(location1, location2)
+    
     def clear_cache(self):
         """Clear the internal data cache."""
         self.data_cache.clear()
\ No newline at end of file
@@ -0,0 +1,151 @@
+"""
+Coordinate helpers shared across the calculation modules.
+
+This module provides small angle utilities so that zodiac, house and aspect
+calculations all measure distances around the ecliptic the same way.
+"""
+
+import math
+from typing import Dict, Tuple
+
+# Default tolerance in degrees for comparing transformed coordinates
+EPSILON = 1e-6
+
+# Mean radius of the Earth in kilometers
+EARTH_RADIUS_KM = 6371.0
+
+
+def normalize_angle(angle: float) -> float:
+    """
+    Normalize an angle to the 0-360 degree range.
+    
+    Args:
+        angle: Angle in degrees
+        
+    Returns:
+        Equivalent angle in degrees (0-360)
+    """
+    return angle % 360
+
+
+def angular_separation(pos1: float, pos2: float) -> float:
+    """
+    Calculate the shortest arc between two ecliptic positions.
+    
+    Args:
+        pos1: First position in degrees
+        pos2: Second position in degrees
+        
+    Returns:
+        Angular separation in degrees (0-180)
+    """
+    diff = abs(normalize_angle(pos2) - normalize_angle(pos1))
+    
+    return min(diff, 360 - diff)
+
+
+def great_circle_separation(lon1: float, lat1: float, lon2: float, lat2: float) -> float:
+    """
+    Calculate the true angular separation between two ecliptic positions.
+    
+    Unlike angular_separation, this accounts for ecliptic latitude.
+    
+    Args:
+        lon1: Ecliptic longitude of the first position in degrees
+        lat1: Ecliptic latitude of the first position in degrees
+        lon2: Ecliptic longitude of the second position in degrees
+        lat2: Ecliptic latitude of the second position in degrees
+        
+    Returns:
+        Angular separation in degrees (0-180)
+    """
+    lat1_rad = math.radians(lat1)
+    lat2_rad = math.radians(lat2)
+    dlon_rad = math.radians(lon2 - lon1)
+    
+    # Haversine form stays accurate for small separations
+    h = (math.sin((lat2_rad - lat1_rad) / 2) ** 2 +
+         math.cos(lat1_rad) * math.cos(lat2_rad) * math.sin(dlon_rad / 2) ** 2)
+    
+    return math.degrees(2 * math.asin(min(1.0, math.sqrt(h))))
+
+
+def coordinates_approx_equal(coord1: Tuple[float, float],
+                             coord2: Tuple[float, float],
+                             eps: float = EPSILON) -> bool:
+    """
+    Compare two spherical coordinates within a tolerance.
+    
+    Works for both ecliptic (longitude, latitude) and equatorial (RA, Dec)
+    pairs in degrees. The first component is compared around the circle, so
+    359.9999999° and 0° are considered equal.
+    
+    Args:
+        coord1: First coordinate pair in degrees
+        coord2: Second coordinate pair in degrees
+        eps: Tolerance in degrees
+        
+    Returns:
+        True if both components agree within the tolerance
+    """
+    return (angular_separation(coord1[0], coord2[0]) <= eps and
+            abs(coord1[1] - coord2[1]) <= eps)
+
+
+def location_distance(location1: Dict[str, float], location2: Dict[str, float]) -> float:
+    """
+    Calculate the surface distance between two geographic locations.
+    
+    Args:
+        location1: First location as a dict with 'lat' and 'lon' keys in degrees
+        location2: Second location as a dict with 'lat' and 'lon' keys in degrees
+        
+    Returns:
+        Great-circle distance in kilometers
+    """
+    separation = great_circle_separation(location1['lon'], location1['lat'],
+                                         location2['lon'], location2['lat'])
+    
+    return math.radians(separation) * EARTH_RADIUS_KM
+
+
+def interpolate_longitude(samples: Dict[float, float], julian_day: float) -> float:
+    """
+    Interpolate an ecliptic longitude from a sparse ephemeris.
+    
+    Uses three-point Lagrange interpolation on the samples nearest the
+    requested instant, unwrapping longitudes that cross 0° Aries.
+    
+    Args:
+        samples: Dictionary mapping Julian Days to longitudes in degrees
+        julian_day: Julian Day to interpolate at
+        
+    Returns:
+        Interpolated longitude in degrees (0-360)
+        
+    Raises:
+        ValueError: If there are fewer than three samples or the Julian Day
+                    lies outside the sampled range
+    """
+    if len(samples) < 3:
+        raise ValueError("At least three samples are required for interpolation")
+    if not min(samples) <= julian_day <= max(samples):
+        raise ValueError(f"Julian Day {julian_day} is outside the sampled range")
+    
+    nearest = sorted(sorted(samples, key=lambda jd: abs(jd - julian_day))[:3])
+    
+    # Unwrap so each longitude follows on from the previous one
+    longitudes = [samples[nearest[0]]]
+    for jd in nearest[1:]:
+        step = (samples[jd] - longitudes[-1] + 180) % 360 - 180
+        longitudes.append(longitudes[-1] + step)
+    
+    result = 0.0
+    for i, (jd_i, lon_i) in enumerate(zip(nearest, longitudes)):
+        weight = 1.0
+        for j, jd_j in enumerate(nearest):
+            if i != j:
+                weight *= (julian_day - jd_j) / (jd_i - jd_j)
+        result += weight * lon_i
+    
+    return normalize_angle(result)
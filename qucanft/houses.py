@@ -9,9 +9,12 @@ planetary house positions.
 from typing import Dict, List, Optional, Tuple
 import numpy as np
 import pandas as pd
-from datetime import datetime
+from datetime import datetime, timedelta, timezone
 import math
 
+from .coordinates import angular_separation
+from .zodiac import ZodiacCalculator
+
 
 class HousesCalculator:
     """
@@ -28,9 +31,38 @@ class HousesCalculator:
         'equal': 'Equal House',
         'whole': 'Whole Sign',
         'campanus': 'Campanus',
-        'regiomontanus': 'Regiomontanus'
+        'regiomontanus': 'Regiomontanus',
+        'natural': 'Natural (Equal from 0° Aries)',
+        'topocentric': 'Topocentric (Polich-Page)',
+        'meridian': 'Meridian (Equal in Right Ascension from MC)',
+        'porphyry': 'Porphyry',
+        'sripati': 'Sripati (Porphyry midpoints)'
+    }
+    
+    # Listed house systems that calculate_houses cannot compute yet
+    UNIMPLEMENTED_HOUSE_SYSTEMS = ('campanus', 'regiomontanus')
+    
+    # Houses in which a luminary can be hyleg (the aphetic places)
+    APHETIC_HOUSES = (1, 7, 9, 10, 11)
+    
+    # Accidental dignity points by house (after William Lilly)
+    ACCIDENTAL_HOUSE_SCORES = {
+        1: 5, 10: 5, 4: 4, 7: 4, 11: 4, 2: 3, 5: 3,
+        9: 2, 3: 1, 6: -2, 8: -2, 12: -5
     }
     
+    # Accidental dignity bonus for a planet conjunct a chart angle
+    ANGLE_CONJUNCTION_BONUS = 5
+    
+    # Accidental dignity bonus for a sextile, square or trine to the ASC or MC
+    ANGLE_ASPECT_BONUS = 2
+    
+    # Naibod arc: mean daily solar motion applied per year of life, in degrees
+    NAIBOD_ARC = 0.98564733
+    
+    # Length of the tropical year in days
+    TROPICAL_YEAR = 365.242189
+    
     # House meanings and themes
     HOUSE_MEANINGS = {
         1: {'name': 'Ascendant/Self', 'theme': 'Identity, appearance, first impressions'},
@@ -47,9 +79,127 @@ class HousesCalculator:
         12: {'name': 'Subconscious', 'theme': 'Subconscious, karma, hidden enemies, sacrifice'}
     }
     
-    def __init__(self):
-        """Initialize the HousesCalculator."""
-        pass
+    def __init__(self, zodiac_calculator: Optional[ZodiacCalculator] = None):
+        """
+        Initialize the HousesCalculator.
+        
+        Args:
+            zodiac_calculator: Calculator used to place cusps and points in signs,
+                              so a reference offset (e.g. an ayanamsa) is honoured.
+                              If None, a tropical ZodiacCalculator is used.
+        """
+        if zodiac_calculator is None:
+            zodiac_calculator = ZodiacCalculator()
+        self.zodiac_calculator = zodiac_calculator
+    
+    def datetime_to_julian_day(self, moment: datetime) -> float:
+        """
+        Convert a datetime to a Julian Day.
+        
+        Args:
+            moment: Date and time (naive values are taken as UTC)
+            
+        Returns:
+            Julian Day (UT)
+        """
+        if moment.tzinfo is None:
+            moment = moment.replace(tzinfo=timezone.utc)
+        
+        unix_epoch = datetime(1970, 1, 1, tzinfo=timezone.utc)
+        
+        return 2440587.5 + (moment - unix_epoch).total_seconds() / 86400
+    
+    def calculate_local_sidereal_time(self, julian_day: float, longitude: float) -> float:
+        """
+        Calculate the local sidereal time for a given instant and meridian.
+        
+        Args:
+            julian_day: Julian Day (UT)
+            longitude: Geographic longitude in degrees (east positive)
+            
+        Returns:
+            Local sidereal time in hours (0-24)
+        """
+        # Greenwich mean sidereal time (Meeus, Astronomical Algorithms, 12.4)
+        d = julian_day - 2451545.0
+        t = d / 36525
+        gmst = (280.46061837 + 360.98564736629 * d +
+                0.000387933 * t ** 2 - t ** 3 / 38710000)
+        
+        return ((gmst + longitude) % 360) / 15
+    
+    def sidereal_time_to_hms(self, sidereal_time: float) -> Tuple[int, int, int]:
+        """
+        Convert sidereal time in decimal hours to hours, minutes, seconds.
+        
+        Args:
+            sidereal_time: Sidereal time in hours
+            
+        Returns:
+            Tuple of (hours, minutes, seconds)
+        """
+        total_seconds = int(round((sidereal_time % 24) * 3600)) % 86400
+        hours, remainder = divmod(total_seconds, 3600)
+        minutes, seconds = divmod(remainder, 60)
+        
+        return hours, minutes, seconds
+    
+    def local_sidereal_time_hms(self, julian_day: float, longitude: float) -> Tuple[int, int, int]:
+        """
+        Calculate the local sidereal time as clock time.
+        
+        Args:
+            julian_day: Julian Day (UT)
+            longitude: Geographic longitude in degrees (east positive)
+            
+        Returns:
+            Tuple of (hours, minutes, seconds)
+        """
+        return self.sidereal_time_to_hms(
+            self.calculate_local_sidereal_time(julian_day, longitude)
+        )
+    
+    def calculate_equation_of_time(self, julian_day: float, obliquity: float = 23.4367) -> float:
+        """
+        Calculate the equation of time (apparent minus mean solar time).
+        
+        Args:
+            julian_day: Julian Day (UT)
+            obliquity: Obliquity of the ecliptic in degrees
+            
+        Returns:
+            Equation of time in minutes
+        """
+        # Low-precision solar elements (Meeus, Astronomical Algorithms, 28.3)
+        t = (julian_day - 2451545.0) / 36525
+        mean_longitude = math.radians((280.46646 + 36000.76983 * t) % 360)
+        mean_anomaly = math.radians((357.52911 + 35999.05029 * t) % 360)
+        eccentricity = 0.016708634 - 0.000042037 * t
+        y = math.tan(math.radians(obliquity) / 2) ** 2
+        
+        equation = (y * math.sin(2 * mean_longitude)
+                    - 2 * eccentricity * math.sin(mean_anomaly)
+                    + 4 * eccentricity * y * math.sin(mean_anomaly) * math.cos(2 * mean_longitude)
+                    - 0.5 * y ** 2 * math.sin(4 * mean_longitude)
+                    - 1.25 * eccentricity ** 2 * math.sin(2 * mean_anomaly))
+        
+        return math.degrees(equation) * 4
+    
+    def local_apparent_time(self, julian_day: float, longitude: float) -> datetime:
+        """
+        Calculate local apparent (sundial) time for an instant and meridian.
+        
+        Args:
+            julian_day: Julian Day (UT)
+            longitude: Geographic longitude in degrees (east positive)
+            
+        Returns:
+            Local apparent time as a naive datetime
+        """
+        local_mean_jd = julian_day + longitude / 360
+        apparent_jd = local_mean_jd + self.calculate_equation_of_time(julian_day) / 1440
+        
+        return datetime(1970, 1, 1) + timedelta(days=apparent_jd - 2440587.5)
     
     def calculate_ascendant(self, 
                           local_sidereal_time: float,
@@ -72,9 +222,8 @@ class HousesCalculator:
         obl_rad = math.radians(obliquity)
         
         # Calculate ascendant using spherical trigonometry
-        # This is a simplified calculation - in practice, you'd use more precise algorithms
-        y = -math.cos(lst_rad)
-        x = math.sin(lst_rad) * math.cos(obl_rad) + math.tan(lat_rad) * math.sin(obl_rad)
+        y = math.cos(lst_rad)
+        x = -(math.sin(lst_rad) * math.cos(obl_rad) + math.tan(lat_rad) * math.sin(obl_rad))
         
         ascendant_rad = math.atan2(y, x)
         ascendant_deg = math.degrees(ascendant_rad)
@@ -82,6 +231,42 @@ class HousesCalculator:
         # Normalize to 0-360 range
         return ascendant_deg % 360
     
+    def calculate_progressed_ascendant(self,
+                                       natal_time: datetime,
+                                       target_time: datetime,
+                                       latitude: float,
+                                       longitude: float,
+                                       obliquity: float = 23.4367) -> float:
+        """
+        Calculate the progressed Ascendant for a target date.
+        
+        The natal sidereal time is advanced by the Naibod arc for each year
+        elapsed since birth, and the rising degree is recomputed from it.
+        
+        Args:
+            natal_time: Birth date and time (naive values are taken as UTC)
+            target_time: Date and time to progress the chart to
+            latitude: Geographic latitude in degrees
+            longitude: Geographic longitude in degrees (east positive)
+            obliquity: Obliquity of the ecliptic in degrees
+            
+        Returns:
+            Progressed Ascendant position in degrees of ecliptic longitude
+            
+        Raises:
+            ValueError: If the target time is before the natal time
+        """
+        natal_jd = self.datetime_to_julian_day(natal_time)
+        target_jd = self.datetime_to_julian_day(target_time)
+        if target_jd < natal_jd:
+            raise ValueError("Target time must not be before the natal time")
+        
+        years = (target_jd - natal_jd) / self.TROPICAL_YEAR
+        natal_lst = self.calculate_local_sidereal_time(natal_jd, longitude)
+        progressed_lst = (natal_lst + years * self.NAIBOD_ARC / 15) % 24
+        
+        return self.calculate_ascendant(progressed_lst, latitude, obliquity)
+    
     def calculate_midheaven(self, 
                            local_sidereal_time: float,
                            obliquity: float = 23.4367) -> float:
@@ -95,14 +280,143 @@ class HousesCalculator:
         Returns:
             Midheaven position in degrees of ecliptic longitude
         """
-        # Convert LST to degrees
-        lst_deg = local_sidereal_time * 15
+        # The MC is the ecliptic point culminating at the local sidereal time
+        return self._ecliptic_from_right_ascension(local_sidereal_time * 15, obliquity)
+    
+    def _right_ascension_from_ecliptic(self, longitude: float, obliquity: float) -> float:
+        """
+        Convert an ecliptic longitude (on the ecliptic) to right ascension.
+        
+        Args:
+            longitude: Ecliptic longitude in degrees
+            obliquity: Obliquity of the ecliptic in degrees
+            
+        Returns:
+            Right ascension in degrees (0-360)
+        """
+        lon_rad = math.radians(longitude)
+        ra_rad = math.atan2(math.sin(lon_rad) * math.cos(math.radians(obliquity)),
+                            math.cos(lon_rad))
+        
+        return math.degrees(ra_rad) % 360
+    
+    def _ecliptic_from_right_ascension(self, right_ascension: float, obliquity: float) -> float:
+        """
+        Find the ecliptic longitude of the ecliptic point with a given right ascension.
+        
+        Args:
+            right_ascension: Right ascension in degrees
+            obliquity: Obliquity of the ecliptic in degrees
+            
+        Returns:
+            Ecliptic longitude in degrees (0-360)
+        """
+        ra_rad = math.radians(right_ascension)
+        lon_rad = math.atan2(math.sin(ra_rad),
+                             math.cos(ra_rad) * math.cos(math.radians(obliquity)))
+        
+        return math.degrees(lon_rad) % 360
+    
+    def _semi_arc_cusp(self,
+                       ramc: float,
+                       declination: float,
+                       latitude: float,
+                       fraction: float) -> float:
+        """
+        Offset a meridian by a fraction of a diurnal semi-arc.
+        
+        This is the spherical trigonometry shared by the Placidus and Koch
+        systems, which differ only in which declination and fractions they use.
         
-        # Calculate MC using spherical trigonometry
-        # This is a simplified calculation
-        mc_deg = lst_deg % 360
+        Args:
+            ramc: Right ascension of the reference meridian in degrees
+            declination: Declination whose diurnal semi-arc is divided, in degrees
+            latitude: Geographic latitude in degrees
+            fraction: Fraction of the semi-arc to move east (negative moves west)
+            
+        Returns:
+            Right ascension in degrees (0-360)
+            
+        Raises:
+            ValueError: If the declination circle never rises or never sets
+        """
+        cos_semi_arc = -math.tan(math.radians(latitude)) * math.tan(math.radians(declination))
+        if abs(cos_semi_arc) > 1:
+            raise ValueError(
+                f"Semi-arc is undefined for declination {declination:.2f}° at latitude {latitude:.2f}°"
+            )
+        
+        semi_arc = math.degrees(math.acos(cos_semi_arc))
+        
+        return (ramc + fraction * semi_arc) % 360
+    
+    def get_chart_angles(self, ascendant: float, midheaven: float) -> Dict[str, float]:
+        """
+        Get the longitudes of the four chart angles.
         
-        return mc_deg
+        Args:
+            ascendant: Ascendant position in degrees
+            midheaven: Midheaven position in degrees
+            
+        Returns:
+            Dictionary with 'ASC', 'MC', 'DESC' and 'IC' longitudes in degrees
+        """
+        return {
+            'ASC': ascendant % 360,
+            'MC': midheaven % 360,
+            'DESC': (ascendant + 180) % 360,
+            'IC': (midheaven + 180) % 360
+        }
+    
+    def calculate_distance_to_angles(self,
+                                     planet_longitude: float,
+                                     ascendant: float,
+                                     midheaven: float) -> Dict[str, float]:
+        """
+        Calculate a planet's distance to each of the chart angles.
+        
+        Args:
+            planet_longitude: Planet's ecliptic longitude in degrees
+            ascendant: Ascendant position in degrees
+            midheaven: Midheaven position in degrees
+            
+        Returns:
+            Dictionary mapping 'ASC', 'MC', 'DESC' and 'IC' to distances in degrees (0-180)
+        """
+        return {
+            angle: angular_separation(planet_longitude, angle_longitude)
+            for angle, angle_longitude in self.get_chart_angles(ascendant, midheaven).items()
+        }
+    
+    def find_angular_planets(self,
+                             planetary_data: pd.DataFrame,
+                             ascendant: float,
+                             midheaven: float,
+                             orb: float = 5.0) -> Dict[str, List[str]]:
+        """
+        Find the planets conjunct each of the chart angles.
+        
+        Args:
+            planetary_data: DataFrame with planetary positions
+            ascendant: Ascendant position in degrees
+            midheaven: Midheaven position in degrees
+            orb: Maximum distance from an angle in degrees
+            
+        Returns:
+            Dictionary mapping 'ASC', 'MC', 'DESC' and 'IC' to planet names
+        """
+        if 'Ecliptic_Longitude' not in planetary_data.columns:
+            raise ValueError("DataFrame must contain 'Ecliptic_Longitude' column")
+        
+        angles = self.get_chart_angles(ascendant, midheaven)
+        angular_planets = {angle: [] for angle in angles}
+        
+        for _, row in planetary_data.iterrows():
+            for angle, angle_longitude in angles.items():
+                if angular_separation(row['Ecliptic_Longitude'], angle_longitude) <= orb:
+                    angular_planets[angle].append(row['Planet'])
+        
+        return angular_planets
     
     def calculate_equal_houses(self, ascendant: float) -> Dict[int, float]:
         """
@@ -111,6 +425,18 @@ class HousesCalculator:
         Args:
             ascendant: Ascendant position in degrees
             
+        Returns:
+            Dictionary with house numbers as keys and cusp positions as values
+        """
+        return self.calculate_equal_houses_from(ascendant)
+    
+    def calculate_equal_houses_from(self, start: float) -> Dict[int, float]:
+        """
+        Calculate 30° equal house cusps starting from an arbitrary degree.
+        
+        Args:
+            start: Longitude of the 1st house cusp in degrees
+            
         Returns:
             Dictionary with house numbers as keys and cusp positions as values
         """
@@ -118,7 +444,7 @@ class HousesCalculator:
         
         for house_num in range(1, 13):
             # In Equal House system, each house is exactly 30 degrees
-            cusp = (ascendant + (house_num - 1) * 30) % 360
+            cusp = (start + (house_num - 1) * 30) % 360
             houses[house_num] = cusp
         
         return houses
@@ -136,12 +462,7 @@ class HousesCalculator:
         # In Whole Sign system, the 1st house starts at 0° of the ascendant sign
         ascendant_sign_start = (int(ascendant // 30)) * 30
         
-        houses = {}
-        for house_num in range(1, 13):
-            cusp = (ascendant_sign_start + (house_num - 1) * 30) % 360
-            houses[house_num] = cusp
-        
-        return houses
+        return self.calculate_equal_houses_from(ascendant_sign_start)
     
     def calculate_placidus_houses(self, 
                                  ascendant: float,
@@ -149,7 +470,107 @@ class HousesCalculator:
                                  latitude: float,
                                  obliquity: float = 23.4367) -> Dict[int, float]:
         """
-        Calculate house cusps using the Placidus system (simplified).
+        Calculate house cusps using the Placidus system.
+        
+        Each intermediate cusp is the ecliptic point that has travelled one or
+        two thirds of its own semi-arc from the meridian, found by iteration.
+        
+        Args:
+            ascendant: Ascendant position in degrees
+            midheaven: Midheaven position in degrees
+            latitude: Geographic latitude in degrees
+            obliquity: Obliquity of the ecliptic in degrees
+            
+        Returns:
+            Dictionary with house numbers as keys and cusp positions as values
+            
+        Raises:
+            ValueError: If a cusp is undefined (inside the polar circles)
+        """
+        ramc = self._right_ascension_from_ecliptic(midheaven, obliquity)
+        sin_obliquity = math.sin(math.radians(obliquity))
+        
+        houses = self._angular_cusps(ascendant, midheaven)
+        
+        # (house, reference meridian, fraction, below horizon)
+        cusp_schemes = [
+            (11, ramc, 1 / 3, False),
+            (12, ramc, 2 / 3, False),
+            (2, ramc + 180, -2 / 3, True),
+            (3, ramc + 180, -1 / 3, True),
+        ]
+        
+        for house_num, meridian, fraction, below_horizon in cusp_schemes:
+            # Start from the RA offset the cusp would have at the equator
+            cusp = self._ecliptic_from_right_ascension(meridian + fraction * 90, obliquity)
+            
+            for _ in range(50):
+                declination = math.degrees(math.asin(sin_obliquity * math.sin(math.radians(cusp))))
+                # Below the horizon the nocturnal semi-arc of δ equals the diurnal semi-arc of -δ
+                if below_horizon:
+                    declination = -declination
+                right_ascension = self._semi_arc_cusp(meridian, declination, latitude, fraction)
+                new_cusp = self._ecliptic_from_right_ascension(right_ascension, obliquity)
+                
+                converged = angular_separation(new_cusp, cusp) < 1e-9
+                cusp = new_cusp
+                if converged:
+                    break
+            
+            houses[house_num] = cusp
+            houses[(house_num + 5) % 12 + 1] = (cusp + 180) % 360
+        
+        return houses
+    
+    def calculate_koch_houses(self,
+                              ascendant: float,
+                              midheaven: float,
+                              latitude: float,
+                              obliquity: float = 23.4367) -> Dict[int, float]:
+        """
+        Calculate house cusps using the Koch (birthplace) system.
+        
+        The MC degree's diurnal semi-arc is divided into thirds, and each
+        intermediate cusp is the Ascendant at the matching sidereal time.
+        
+        Args:
+            ascendant: Ascendant position in degrees
+            midheaven: Midheaven position in degrees
+            latitude: Geographic latitude in degrees
+            obliquity: Obliquity of the ecliptic in degrees
+            
+        Returns:
+            Dictionary with house numbers as keys and cusp positions as values
+            
+        Raises:
+            ValueError: If the MC degree is circumpolar at this latitude
+        """
+        ramc = self._right_ascension_from_ecliptic(midheaven, obliquity)
+        mc_declination = math.degrees(math.asin(
+            math.sin(math.radians(obliquity)) * math.sin(math.radians(midheaven))
+        ))
+        
+        houses = self._angular_cusps(ascendant, midheaven)
+        
+        for house_num, fraction in [(11, -2 / 3), (12, -1 / 3), (2, 1 / 3), (3, 2 / 3)]:
+            sidereal_ramc = self._semi_arc_cusp(ramc, mc_declination, latitude, fraction)
+            cusp = self.calculate_ascendant(sidereal_ramc / 15, latitude, obliquity)
+            houses[house_num] = cusp
+            houses[(house_num + 5) % 12 + 1] = (cusp + 180) % 360
+        
+        return houses
+    
+    def calculate_topocentric_houses(self,
+                                     ascendant: float,
+                                     midheaven: float,
+                                     latitude: float,
+                                     obliquity: float = 23.4367) -> Dict[int, float]:
+        """
+        Calculate house cusps using the Topocentric (Polich-Page) system.
+        
+        Each intermediate cusp is the Ascendant for a sidereal time 30° or 60°
+        from the RAMC, computed at a pole whose tangent is one or two thirds of
+        the tangent of the geographic latitude.
         
         Args:
             ascendant: Ascendant position in degrees
@@ -159,54 +580,128 @@ class HousesCalculator:
             
         Returns:
             Dictionary with house numbers as keys and cusp positions as values
+        """
+        ramc = self._right_ascension_from_ecliptic(midheaven, obliquity)
+        tan_latitude = math.tan(math.radians(latitude))
+        pole1 = math.degrees(math.atan(tan_latitude / 3))
+        pole2 = math.degrees(math.atan(tan_latitude * 2 / 3))
+        
+        houses = self._angular_cusps(ascendant, midheaven)
+        
+        for house_num, offset, pole in [(11, -60, pole1), (12, -30, pole2),
+                                        (2, 30, pole2), (3, 60, pole1)]:
+            cusp = self.calculate_ascendant((ramc + offset) / 15, pole, obliquity)
+            houses[house_num] = cusp
+            houses[(house_num + 5) % 12 + 1] = (cusp + 180) % 360
+        
+        return houses
+    
+    def calculate_meridian_houses(self,
+                                  midheaven: float,
+                                  obliquity: float = 23.4367) -> Dict[int, float]:
+        """
+        Calculate house cusps using the Meridian (axial rotation) system.
+        
+        The equator is divided into twelve 30° arcs of right ascension starting
+        from the RAMC, and each division is projected onto the ecliptic. The
+        1st cusp is the equatorial ascendant, so the Ascendant floats free of it.
+        
+        Args:
+            midheaven: Midheaven position in degrees
+            obliquity: Obliquity of the ecliptic in degrees
             
-        Note:
-            This is a simplified implementation. A full Placidus calculation
-            requires complex iterative algorithms and is typically done using
-            specialized libraries like Swiss Ephemeris.
+        Returns:
+            Dictionary with house numbers as keys and cusp positions as values
         """
+        ramc = self._right_ascension_from_ecliptic(midheaven, obliquity)
+        
         houses = {}
+        for house_num in range(1, 13):
+            right_ascension = ramc + 30 * ((house_num - 10) % 12)
+            houses[house_num] = self._ecliptic_from_right_ascension(right_ascension, obliquity)
         
-        # Set the angular houses (1st, 4th, 7th, 10th)
-        houses[1] = ascendant
-        houses[4] = (midheaven + 180) % 360  # IC (Imum Coeli)
-        houses[7] = (ascendant + 180) % 360  # Descendant
-        houses[10] = midheaven  # MC (Medium Coeli)
-        
-        # Simplified calculation for intermediate houses
-        # In practice, this would use complex trigonometry
-        for house_num in [2, 3, 5, 6, 8, 9, 11, 12]:
-            if house_num in [2, 3]:
-                # Houses 2 and 3 between ASC and MC
-                factor = (house_num - 1) / 3
-                angle_diff = (midheaven - ascendant) % 360
-                if angle_diff > 180:
-                    angle_diff = 360 - angle_diff
-                houses[house_num] = (ascendant + factor * angle_diff) % 360
-            elif house_num in [5, 6]:
-                # Houses 5 and 6 between MC and DSC
-                factor = (house_num - 4) / 3
-                angle_diff = (houses[7] - midheaven) % 360
-                if angle_diff > 180:
-                    angle_diff = 360 - angle_diff
-                houses[house_num] = (midheaven + factor * angle_diff) % 360
-            elif house_num in [8, 9]:
-                # Houses 8 and 9 between DSC and IC
-                factor = (house_num - 7) / 3
-                angle_diff = (houses[4] - houses[7]) % 360
-                if angle_diff > 180:
-                    angle_diff = 360 - angle_diff
-                houses[house_num] = (houses[7] + factor * angle_diff) % 360
-            elif house_num in [11, 12]:
-                # Houses 11 and 12 between IC and ASC
-                factor = (house_num - 10) / 3
-                angle_diff = (ascendant - houses[4]) % 360
-                if angle_diff > 180:
-                    angle_diff = 360 - angle_diff
-                houses[house_num] = (houses[4] + factor * angle_diff) % 360
+        # Use the given MC for the 10th cusp to avoid a round-trip error
+        houses[10] = midheaven % 360
         
         return houses
     
+    def calculate_porphyry_houses(self, ascendant: float, midheaven: float) -> Dict[int, float]:
+        """
+        Calculate house cusps using the Porphyry system.
+        
+        Each quadrant between the angles is divided into three equal parts.
+        
+        Args:
+            ascendant: Ascendant position in degrees
+            midheaven: Midheaven position in degrees
+            
+        Returns:
+            Dictionary with house numbers as keys and cusp positions as values
+        """
+        houses = self._angular_cusps(ascendant, midheaven)
+        
+        for start in (1, 4, 7, 10):
+            end = (start + 2) % 12 + 1
+            arc = (houses[end] - houses[start]) % 360
+            houses[start + 1] = (houses[start] + arc / 3) % 360
+            houses[start + 2] = (houses[start] + 2 * arc / 3) % 360
+        
+        return houses
+    
+    def calculate_sripati_houses(self, ascendant: float, midheaven: float) -> Dict[int, float]:
+        """
+        Calculate house cusps using the Sripati system.
+        
+        The Porphyry cusps become the middles of the houses, and each
+        Sripati cusp lies midway between two consecutive Porphyry cusps.
+        
+        Args:
+            ascendant: Ascendant position in degrees
+            midheaven: Midheaven position in degrees
+            
+        Returns:
+            Dictionary with house numbers as keys and cusp positions as values
+        """
+        midpoints = self.calculate_house_midpoints(self.calculate_porphyry_houses(ascendant, midheaven))
+        
+        return {house_num: midpoints[(house_num - 2) % 12 + 1] for house_num in range(1, 13)}
+    
+    def calculate_house_midpoints(self, house_cusps: Dict[int, float]) -> Dict[int, float]:
+        """
+        Calculate the midpoint of each house (the bhava madhya in Jyotish).
+        
+        Args:
+            house_cusps: Dictionary of house cusps
+            
+        Returns:
+            Dictionary with house numbers as keys and midpoint longitudes as values
+        """
+        midpoints = {}
+        for house_num in range(1, 13):
+            cusp = house_cusps[house_num]
+            width = (house_cusps[house_num % 12 + 1] - cusp) % 360
+            midpoints[house_num] = (cusp + width / 2) % 360
+        
+        return midpoints
+    
+    def _angular_cusps(self, ascendant: float, midheaven: float) -> Dict[int, float]:
+        """
+        Build the four angular house cusps from the Ascendant and Midheaven.
+        
+        Args:
+            ascendant: Ascendant position in degrees
+            midheaven: Midheaven position in degrees
+            
+        Returns:
+            Dictionary with cusps for houses 1, 4, 7 and 10
+        """
+        return {
+            1: ascendant,
+            4: (midheaven + 180) % 360,  # IC (Imum Coeli)
+            7: (ascendant + 180) % 360,  # Descendant
+            10: midheaven  # MC (Medium Coeli)
+        }
+    
     def calculate_houses(self, 
                         ascendant: float,
                         midheaven: Optional[float] = None,
@@ -230,24 +725,203 @@ class HousesCalculator:
             return self.calculate_equal_houses(ascendant)
         elif house_system == 'whole':
             return self.calculate_whole_sign_houses(ascendant)
+        elif house_system == 'topocentric':
+            if midheaven is None or latitude is None:
+                raise ValueError("Topocentric system requires midheaven and latitude")
+            return self.calculate_topocentric_houses(ascendant, midheaven, latitude)
+        elif house_system == 'meridian':
+            if midheaven is None:
+                raise ValueError("Meridian system requires midheaven")
+            return self.calculate_meridian_houses(midheaven)
+        elif house_system in ('porphyry', 'sripati'):
+            if midheaven is None:
+                raise ValueError(f"{self.HOUSE_SYSTEMS[house_system]} system requires midheaven")
+            if house_system == 'porphyry':
+                return self.calculate_porphyry_houses(ascendant, midheaven)
+            return self.calculate_sripati_houses(ascendant, midheaven)
+        elif house_system == 'natural':
+            return self.calculate_equal_houses_from(0)
         elif house_system == 'placidus':
             if midheaven is None or latitude is None:
                 raise ValueError("Placidus system requires midheaven and latitude")
             return self.calculate_placidus_houses(ascendant, midheaven, latitude)
+        elif house_system == 'koch':
+            if midheaven is None or latitude is None:
+                raise ValueError("Koch system requires midheaven and latitude")
+            return self.calculate_koch_houses(ascendant, midheaven, latitude)
         else:
             # Default to equal house system
             print(f"Warning: House system '{house_system}' not implemented, using Equal House")
             return self.calculate_equal_houses(ascendant)
     
+    def calculate_derived_houses(self, house_cusps: Dict[int, float], new_first: int) -> Dict[int, float]:
+        """
+        Turn the chart so that another house becomes the 1st (derived houses).
+        
+        Args:
+            house_cusps: Dictionary of house cusps
+            new_first: House number (1-12) to treat as the new 1st house
+            
+        Returns:
+            Dictionary of derived house numbers mapped to cusp positions
+            
+        Raises:
+            ValueError: If new_first is not between 1 and 12
+        """
+        if not 1 <= new_first <= 12:
+            raise ValueError(f"House number must be between 1 and 12, got {new_first}")
+        
+        return {
+            house_num: house_cusps[(new_first + house_num - 2) % 12 + 1]
+            for house_num in range(1, 13)
+        }
+    
+    def get_derived_angles(self, house_cusps: Dict[int, float], new_first: int) -> Dict[str, float]:
+        """
+        Get the chart angles of a turned chart.
+        
+        The derived angles are the cusps of the derived 1st, 10th, 7th and
+        4th houses, so turning to the 7th makes the Descendant the Ascendant.
+        
+        Args:
+            house_cusps: Dictionary of house cusps
+            new_first: House number (1-12) to treat as the new 1st house
+            
+        Returns:
+            Dictionary with 'ASC', 'MC', 'DESC' and 'IC' longitudes in degrees
+        """
+        derived = self.calculate_derived_houses(house_cusps, new_first)
+        
+        return {
+            'ASC': derived[1],
+            'MC': derived[10],
+            'DESC': derived[7],
+            'IC': derived[4]
+        }
+    
+    def compare_house_systems(self,
+                              ascendant: float,
+                              midheaven: Optional[float] = None,
+                              latitude: Optional[float] = None,
+                              house_systems: Optional[List[str]] = None) -> Dict[str, Dict[int, float]]:
+        """
+        Calculate house cusps for several house systems at once.
+        
+        Args:
+            ascendant: Ascendant position in degrees
+            midheaven: Midheaven position in degrees (required for some systems)
+            latitude: Geographic latitude in degrees (required for some systems)
+            house_systems: House systems to calculate. If None, uses all
+                          implemented systems.
+            
+        Returns:
+            Dictionary mapping each house system to its house cusps
+            
+        Raises:
+            ValueError: If a house system is unknown or not implemented
+        """
+        if house_systems is None:
+            house_systems = [
+                house_system for house_system in self.HOUSE_SYSTEMS
+                if house_system not in self.UNIMPLEMENTED_HOUSE_SYSTEMS
+            ]
+        
+        results = {}
+        for house_system in house_systems:
+            if house_system.lower() not in self.HOUSE_SYSTEMS:
+                raise ValueError(f"Unknown house system: {house_system}")
+            if house_system.lower() in self.UNIMPLEMENTED_HOUSE_SYSTEMS:
+                raise ValueError(f"House system not implemented: {house_system}")
+            results[house_system] = self.calculate_houses(
+                ascendant, midheaven, latitude, house_system
+            )
+        
+        return results
+    
+    def house_placement_across_systems(self,
+                                       planet_longitude: float,
+                                       ascendant: float,
+                                       midheaven: Optional[float] = None,
+                                       latitude: Optional[float] = None,
+                                       house_systems: Optional[List[str]] = None) -> Dict[str, int]:
+        """
+        Determine a planet's house under several house systems.
+        
+        Args:
+            planet_longitude: Planet's ecliptic longitude in degrees
+            ascendant: Ascendant position in degrees
+            midheaven: Midheaven position in degrees (required for some systems)
+            latitude: Geographic latitude in degrees (required for some systems)
+            house_systems: House systems to compare. If None, uses all
+                          implemented systems.
+            
+        Returns:
+            Dictionary mapping each house system to the planet's house number
+            
+        Raises:
+            ValueError: If a house system is unknown or not implemented
+        """
+        cusps_by_system = self.compare_house_systems(ascendant, midheaven, latitude, house_systems)
+        
+        return {
+            house_system: self.determine_planet_house(planet_longitude, cusps)
+            for house_system, cusps in cusps_by_system.items()
+        }
+    
+    def calculate_hybrid_house_placement(self,
+                                         planetary_data: pd.DataFrame,
+                                         ascendant: float,
+                                         midheaven: float,
+                                         latitude: float) -> Dict[str, Dict[str, float]]:
+        """
+        Place planets by Whole Sign houses for topics and Placidus cusps for strength.
+        
+        The Placidus strength falls linearly from 1 on a house cusp to 0 at
+        the next cusp, so planets just past a cusp are the most emphasized.
+        
+        Args:
+            planetary_data: DataFrame with planetary positions
+            ascendant: Ascendant position in degrees
+            midheaven: Midheaven position in degrees
+            latitude: Geographic latitude in degrees
+            
+        Returns:
+            Dictionary mapping planet names to 'whole_sign_house',
+            'placidus_house' and 'placidus_strength'
+        """
+        if 'Ecliptic_Longitude' not in planetary_data.columns:
+            raise ValueError("DataFrame must contain 'Ecliptic_Longitude' column")
+        
+        whole_sign_cusps = self.calculate_whole_sign_houses(ascendant)
+        placidus_cusps = self.calculate_placidus_houses(ascendant, midheaven, latitude)
+        
+        placements = {}
+        for _, row in planetary_data.iterrows():
+            longitude = row['Ecliptic_Longitude']
+            placidus_house = self.determine_planet_house(longitude, placidus_cusps)
+            cusp = placidus_cusps[placidus_house]
+            width = (placidus_cusps[placidus_house % 12 + 1] - cusp) % 360
+            
+            placements[row['Planet']] = {
+                'whole_sign_house': self.determine_planet_house(longitude, whole_sign_cusps),
+                'placidus_house': placidus_house,
+                'placidus_strength': 1 - ((longitude - cusp) % 360) / width
+            }
+        
+        return placements
+    
     def determine_planet_house(self, 
                              planet_longitude: float,
-                             house_cusps: Dict[int, float]) -> int:
+                             house_cusps: Dict[int, float],
+                             cusp_orb: float = 0.0) -> int:
         """
         Determine which house a planet is in based on its longitude.
         
         Args:
             planet_longitude: Planet's ecliptic longitude in degrees
             house_cusps: Dictionary of house cusps
+            cusp_orb: Planets within this many degrees before a cusp are
+                     counted in the following house (0 disables the rule)
             
         Returns:
             House number (1-12) that the planet is in
@@ -255,6 +929,72 @@ class HousesCalculator:
         # Normalize planet longitude to 0-360
         planet_longitude = planet_longitude % 360
         
+        house_num = self._find_house(planet_longitude, house_cusps)
+        
+        # Cusp conjunction rule: promote planets just short of the next cusp
+        if cusp_orb > 0:
+            next_house = house_num % 12 + 1
+            if (house_cusps[next_house] - planet_longitude) % 360 <= cusp_orb:
+                return next_house
+        
+        return house_num
+    
+    def find_intercepted_signs(self,
+                               house_cusps: Dict[int, float],
+                               zodiac_calculator: Optional[ZodiacCalculator] = None) -> Dict[int, List[str]]:
+        """
+        Find signs wholly contained within a house (intercepted signs).
+        
+        Args:
+            house_cusps: Dictionary of house cusps
+            zodiac_calculator: Calculator whose reference offset places the sign
+                              boundaries. If None, uses this calculator's own.
+            
+        Returns:
+            Dictionary mapping house numbers to the names of the signs
+            intercepted in them (houses without interceptions are omitted)
+        """
+        if zodiac_calculator is None:
+            zodiac_calculator = self.zodiac_calculator
+        
+        intercepted = {}
+        for house_num in range(1, 13):
+            cusp = zodiac_calculator.to_zodiac_longitude(house_cusps[house_num])
+            width = (house_cusps[house_num % 12 + 1] - house_cusps[house_num]) % 360
+            
+            for sign_index, sign in enumerate(zodiac_calculator.ZODIAC_SIGNS):
+                distance = (sign_index * 30 - cusp) % 360
+                if 0 < distance and distance + 30 <= width:
+                    intercepted.setdefault(house_num, []).append(sign['name'])
+        
+        return intercepted
+    
+    def get_antiscion_house(self, planet_longitude: float, house_cusps: Dict[int, float]) -> int:
+        """
+        Determine which house a planet's antiscion falls in.
+        
+        Args:
+            planet_longitude: Planet's ecliptic longitude in degrees
+            house_cusps: Dictionary of house cusps
+            
+        Returns:
+            House number (1-12) of the antiscion
+        """
+        antiscion = self.zodiac_calculator.calculate_antiscion(planet_longitude)
+        
+        return self.determine_planet_house(antiscion, house_cusps)
+    
+    def _find_house(self, planet_longitude: float, house_cusps: Dict[int, float]) -> int:
+        """
+        Find the house whose cusps enclose a longitude.
+        
+        Args:
+            planet_longitude: Ecliptic longitude in degrees (0-360)
+            house_cusps: Dictionary of house cusps
+            
+        Returns:
+            House number (1-12) containing the longitude
+        """
         for house_num in range(1, 13):
             cusp_current = house_cusps[house_num]
             cusp_next = house_cusps[house_num + 1] if house_num < 12 else house_cusps[1]
@@ -272,15 +1012,131 @@ class HousesCalculator:
         # Fallback (shouldn't happen with correct calculations)
         return 1
     
+    def is_day_chart(self, sun_longitude: float, ascendant: float) -> bool:
+        """
+        Determine whether a chart is diurnal (Sun above the horizon).
+        
+        The horizon runs from the Ascendant to the Descendant, so this holds
+        whatever the house system, including whole sign houses whose first
+        cusp is not the Ascendant.
+        
+        Args:
+            sun_longitude: Sun's ecliptic longitude in degrees
+            ascendant: Ascendant position in degrees
+            
+        Returns:
+            True if the Sun is within the 180° of the ecliptic that has
+            already risen, False otherwise
+        """
+        return (sun_longitude - ascendant) % 360 >= 180
+    
+    def calculate_part_of_fortune(self,
+                                  sun_longitude: float,
+                                  moon_longitude: float,
+                                  ascendant: float) -> float:
+        """
+        Calculate the Part of Fortune using the sect of the chart.
+        
+        By day the Part is ASC + Moon - Sun; by night the Sun and Moon swap.
+        
+        Args:
+            sun_longitude: Sun's ecliptic longitude in degrees
+            moon_longitude: Moon's ecliptic longitude in degrees
+            ascendant: Ascendant position in degrees
+            
+        Returns:
+            Longitude of the Part of Fortune in degrees (0-360)
+        """
+        return self.zodiac_calculator.calculate_named_part(
+            'Fortune',
+            ascendant,
+            {'Sun': sun_longitude, 'Moon': moon_longitude},
+            is_day=self.is_day_chart(sun_longitude, ascendant)
+        )
+    
+    def get_part_of_fortune_house(self,
+                                  sun_longitude: float,
+                                  moon_longitude: float,
+                                  ascendant: float,
+                                  house_cusps: Dict[int, float]) -> int:
+        """
+        Determine which house the Part of Fortune falls in.
+        
+        Args:
+            sun_longitude: Sun's ecliptic longitude in degrees
+            moon_longitude: Moon's ecliptic longitude in degrees
+            ascendant: Ascendant position in degrees
+            house_cusps: Dictionary of house cusps
+            
+        Returns:
+            House number (1-12) of the Part of Fortune
+        """
+        fortune = self.calculate_part_of_fortune(sun_longitude, moon_longitude, ascendant)
+        
+        return self.determine_planet_house(fortune, house_cusps)
+    
+    def get_sect_light(self, sun_longitude: float, ascendant: float) -> str:
+        """
+        Get the luminary of the chart's sect.
+        
+        Args:
+            sun_longitude: Sun's ecliptic longitude in degrees
+            ascendant: Ascendant position in degrees
+            
+        Returns:
+            'Sun' for a day chart, 'Moon' for a night chart
+        """
+        return 'Sun' if self.is_day_chart(sun_longitude, ascendant) else 'Moon'
+    
+    def find_hyleg(self,
+                   planetary_data: pd.DataFrame,
+                   house_cusps: Dict[int, float],
+                   ascendant: float) -> str:
+        """
+        Find the hyleg (giver of life) of a chart.
+        
+        The luminary of the sect is considered first, then the other luminary,
+        and each qualifies only if it occupies an aphetic place. If neither
+        luminary qualifies, the Ascendant is hyleg. This is a simplification
+        of the traditional rules: the candidates' dignity is not weighed and
+        the Part of Fortune and prenatal lunation are never considered.
+        
+        Args:
+            planetary_data: DataFrame with planetary positions (must include Sun and Moon)
+            house_cusps: Dictionary of house cusps
+            ascendant: Ascendant position in degrees, which sets the sect
+            
+        Returns:
+            'Sun', 'Moon' or 'Ascendant'
+        """
+        if 'Ecliptic_Longitude' not in planetary_data.columns:
+            raise ValueError("DataFrame must contain 'Ecliptic_Longitude' column")
+        
+        positions = dict(zip(planetary_data['Planet'], planetary_data['Ecliptic_Longitude']))
+        if 'Sun' not in positions or 'Moon' not in positions:
+            raise ValueError("Planetary data must include the Sun and the Moon")
+        
+        sect_light = self.get_sect_light(positions['Sun'], ascendant)
+        candidates = [sect_light, 'Moon' if sect_light == 'Sun' else 'Sun']
+        
+        for candidate in candidates:
+            if self.determine_planet_house(positions[candidate], house_cusps) in self.APHETIC_HOUSES:
+                return candidate
+        
+        return 'Ascendant'
+    
     def add_house_positions(self, 
                            planetary_data: pd.DataFrame,
-                           house_cusps: Dict[int, float]) -> pd.DataFrame:
+                           house_cusps: Dict[int, float],
+                           cusp_orb: float = 0.0) -> pd.DataFrame:
         """
         Add house positions to planetary data.
         
         Args:
             planetary_data: DataFrame with planetary positions
             house_cusps: Dictionary of house cusps
+            cusp_orb: Planets within this many degrees before a cusp are
+                     counted in the following house (0 disables the rule)
             
         Returns:
             DataFrame with added house position columns
@@ -295,7 +1151,7 @@ class HousesCalculator:
         house_meanings = []
         
         for _, row in planetary_data.iterrows():
-            house_num = self.determine_planet_house(row['Ecliptic_Longitude'], house_cusps)
+            house_num = self.determine_planet_house(row['Ecliptic_Longitude'], house_cusps, cusp_orb)
             house_positions.append(house_num)
             house_meanings.append(self.HOUSE_MEANINGS[house_num]['theme'])
         
@@ -305,6 +1161,86 @@ class HousesCalculator:
         
         return result_df
     
+    def calculate_chart_frame(self,
+                              julian_day: float,
+                              latitude: float,
+                              longitude: float,
+                              house_system: str = 'equal') -> Dict[str, any]:
+        """
+        Calculate the angles and house cusps for an instant and place.
+        
+        Args:
+            julian_day: Julian Day (UT)
+            latitude: Geographic latitude in degrees
+            longitude: Geographic longitude in degrees (east positive)
+            house_system: House system to use ('equal', 'whole', 'placidus', etc.)
+            
+        Returns:
+            Dictionary with 'local_sidereal_time' (hours), 'ascendant',
+            'midheaven' and 'houses' (house cusps)
+        """
+        local_sidereal_time = self.calculate_local_sidereal_time(julian_day, longitude)
+        ascendant = self.calculate_ascendant(local_sidereal_time, latitude)
+        midheaven = self.calculate_midheaven(local_sidereal_time)
+        
+        return {
+            'local_sidereal_time': local_sidereal_time,
+            'ascendant': ascendant,
+            'midheaven': midheaven,
+            'houses': self.calculate_houses(ascendant, midheaven, latitude, house_system)
+        }
+    
+    def relocate_chart(self,
+                       planetary_data: pd.DataFrame,
+                       julian_day: float,
+                       location: Dict[str, float],
+                       house_system: str = 'equal') -> Tuple[pd.DataFrame, Dict[str, any]]:
+        """
+        Recast a chart's angles and houses for a different location.
+        
+        The birth moment and planetary longitudes are kept; only the local
+        sidereal time, angles and house placements change.
+        
+        Args:
+            planetary_data: DataFrame with planetary positions
+            julian_day: Julian Day (UT) of the chart
+            location: New location as a dict with 'lat' and 'lon' keys
+            house_system: House system to use ('equal', 'whole', 'placidus', etc.)
+            
+        Returns:
+            Tuple of (planetary data with house positions for the new location,
+            chart frame as returned by calculate_chart_frame)
+        """
+        frame = self.calculate_chart_frame(julian_day, location['lat'], location['lon'], house_system)
+        
+        return self.add_house_positions(planetary_data, frame['houses']), frame
+    
+    def calculate_charts_for_locations(self,
+                                       planetary_data: pd.DataFrame,
+                                       julian_day: float,
+                                       locations: List[Dict[str, float]],
+                                       house_system: str = 'equal') -> List[Tuple[pd.DataFrame, Dict[str, any]]]:
+        """
+        Cast the same moment for several locations.
+        
+        Planetary longitudes are shared; each location gets its own angles,
+        house cusps and house placements.
+        
+        Args:
+            planetary_data: DataFrame with planetary positions
+            julian_day: Julian Day (UT) of the charts
+            locations: Locations as dicts with 'lat' and 'lon' keys
+            house_system: House system to use ('equal', 'whole', 'placidus', etc.)
+            
+        Returns:
+            List of (planetary data with house positions, chart frame) tuples,
+            one per location in the given order
+        """
+        return [
+            self.relocate_chart(planetary_data, julian_day, location, house_system)
+            for location in locations
+        ]
+    
     def get_house_info(self, house_number: int) -> Optional[Dict[str, str]]:
         """
         Get information about a specific house.
@@ -319,6 +1255,93 @@ class HousesCalculator:
             return self.HOUSE_MEANINGS[house_number].copy()
         return None
     
+    def calculate_accidental_dignity(self,
+                                     planetary_data: pd.DataFrame,
+                                     house_cusps: Dict[int, float],
+                                     ascendant: float,
+                                     midheaven: float,
+                                     orb: float = 5.0) -> Dict[str, int]:
+        """
+        Score each planet's accidental dignity from its house and angular contacts.
+        
+        Planets conjunct an angle, or in sextile, square or trine to the
+        Ascendant or Midheaven, gain points on top of their house score.
+        
+        Args:
+            planetary_data: DataFrame with planetary positions
+            house_cusps: Dictionary of house cusps
+            ascendant: Ascendant position in degrees
+            midheaven: Midheaven position in degrees
+            orb: Maximum distance from an exact contact in degrees
+            
+        Returns:
+            Dictionary mapping planet names to accidental dignity scores
+        """
+        if 'Ecliptic_Longitude' not in planetary_data.columns:
+            raise ValueError("DataFrame must contain 'Ecliptic_Longitude' column")
+        
+        angular_planets = self.find_angular_planets(planetary_data, ascendant, midheaven, orb)
+        conjunct = {planet for planets in angular_planets.values() for planet in planets}
+        
+        scores = {}
+        for _, row in planetary_data.iterrows():
+            longitude = row['Ecliptic_Longitude']
+            house_num = self.determine_planet_house(longitude, house_cusps)
+            score = self.ACCIDENTAL_HOUSE_SCORES[house_num]
+            
+            if row['Planet'] in conjunct:
+                score += self.ANGLE_CONJUNCTION_BONUS
+            elif any(abs(angular_separation(longitude, angle) - aspect) <= orb
+                     for angle in (ascendant, midheaven) for aspect in (60, 90, 120)):
+                score += self.ANGLE_ASPECT_BONUS
+            
+            scores[row['Planet']] = score
+        
+        return scores
+    
+    def find_strongest_planet(self,
+                              planetary_data: pd.DataFrame,
+                              house_cusps: Dict[int, float],
+                              ascendant: float,
+                              midheaven: float,
+                              aspects_df: Optional[pd.DataFrame] = None) -> Tuple[str, int]:
+        """
+        Find the most dignified planet in a chart.
+        
+        Each planet scores its essential dignity (including face and reception
+        by its dispositor), its accidental dignity, and one point for every
+        aspect it makes. Ties go to the planet listed first.
+        
+        Args:
+            planetary_data: DataFrame with planetary positions
+            house_cusps: Dictionary of house cusps
+            ascendant: Ascendant position in degrees
+            midheaven: Midheaven position in degrees
+            aspects_df: DataFrame with aspects (from AspectsCalculator), optional
+            
+        Returns:
+            Tuple of (planet name, total score)
+        """
+        if planetary_data.empty:
+            raise ValueError("No planets to rank")
+        
+        accidental = self.calculate_accidental_dignity(planetary_data, house_cusps,
+                                                       ascendant, midheaven)
+        
+        scores = {}
+        for _, row in planetary_data.iterrows():
+            planet = row['Planet']
+            score = self.zodiac_calculator.calculate_full_dignity(
+                planet, row['Ecliptic_Longitude'], aspects_df)
+            score += accidental[planet]
+            if aspects_df is not None and not aspects_df.empty:
+                score += int(((aspects_df['planet1'] == planet) |
+                              (aspects_df['planet2'] == planet)).sum())
+            scores[planet] = score
+        
+        strongest = max(scores, key=scores.get)
+        return strongest, scores[strongest]
+    
     def calculate_house_strengths(self, 
                                 planetary_data: pd.DataFrame,
                                 house_cusps: Dict[int, float]) -> Dict[int, int]:
@@ -354,13 +1377,18 @@ class HousesCalculator:
         Returns:
             Formatted string with house number and position
         """
-        # Convert longitude to zodiac position
-        sign_index = int(cusp_longitude // 30)
-        degree_in_sign = cusp_longitude % 30
-        
-        zodiac_signs = ['Aries', 'Taurus', 'Gemini', 'Cancer', 'Leo', 'Virgo',
-                       'Libra', 'Scorpio', 'Sagittarius', 'Capricorn', 'Aquarius', 'Pisces']
+        return f"House {house_num}: {self.format_cusp_in_sign(cusp_longitude)}"
+    
+    def format_cusp_in_sign(self, cusp_longitude: float) -> str:
+        """
+        Format a house cusp in sign notation (e.g. "15°30' Taurus").
         
-        sign_name = zodiac_signs[sign_index]
+        Args:
+            cusp_longitude: Cusp longitude in degrees
+            
+        Returns:
+            Formatted degrees and minutes within the sign, followed by the sign name
+        """
+        sign_name, deg, min_val, _ = self.zodiac_calculator.get_position_components(cusp_longitude)
         
-        return f"House {house_num}: {int(degree_in_sign)}°{int((degree_in_sign % 1) * 60):02d}' {sign_name}"
\ No newline at end of file
+        return f"{deg}°{min_val:02d}' {sign_name}"
\ No newline at end of file
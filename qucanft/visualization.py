@@ -11,8 +11,16 @@ import numpy as np
 import matplotlib.pyplot as plt
 import matplotlib.patches as patches
 from matplotlib.patches import Circle, Wedge
+from matplotlib.lines import Line2D
+from PIL import Image
+import json
 import math
 
+from .aspects import AspectsCalculator
+from .coordinates import angular_separation
+from .houses import HousesCalculator
+from .zodiac import ZodiacCalculator
+
 
 class VisualizationHelper:
     """
@@ -65,9 +73,86 @@ class VisualizationHelper:
         'Pluto': '♇'
     }
     
-    def __init__(self):
-        """Initialize the VisualizationHelper."""
-        pass
+    # Marker styles for planets in a condition relative to the Sun
+    SOLAR_CONDITION_STYLES = {
+        'Cazimi': {'size': 350, 'alpha': 1.0, 'edgecolor': 'gold', 'linewidth': 3},
+        'Combust': {'size': 120, 'alpha': 0.35, 'edgecolor': 'black', 'linewidth': 1},
+        None: {'size': 200, 'alpha': 0.8, 'edgecolor': 'black', 'linewidth': 1}
+    }
+    
+    # PNG tEXt keyword used to embed chart data in saved images
+    CHART_DATA_KEY = 'Qucanft-Chart'
+    
+    def __init__(self, aspect_colors: Optional[Dict[str, str]] = None):
+        """
+        Initialize the VisualizationHelper.
+        
+        Args:
+            aspect_colors: Colors keyed by aspect name (e.g. {'Square': 'orange'})
+                          overriding the default nature-based palette
+        """
+        self.aspect_colors = aspect_colors or {}
+    
+    def get_aspect_color(self, aspect: str, nature: str) -> Tuple[str, float]:
+        """
+        Get the line color and opacity used to draw an aspect.
+        
+        Args:
+            aspect: Name of the aspect
+            nature: Nature of the aspect (Harmonious, Challenging, etc.)
+            
+        Returns:
+            Tuple of (color, alpha)
+        """
+        # Color based on aspect nature
+        if nature == 'Harmonious':
+            color, alpha = 'blue', 0.6
+        elif nature == 'Challenging':
+            color, alpha = 'red', 0.6
+        else:
+            color, alpha = 'gray', 0.4
+        
+        if aspect in self.aspect_colors:
+            color, alpha = self.aspect_colors[aspect], 0.6
+        
+        return color, alpha
+    
+    def get_glyph(self, name: str) -> str:
+        """
+        Get the glyph for a planet, zodiac sign or aspect name.
+        
+        Args:
+            name: Name of a planet (e.g. 'Sun'), sign (e.g. 'Aries') or
+                 aspect (e.g. 'Trine')
+            
+        Returns:
+            The glyph, or the name itself if it has none
+        """
+        if name in self.PLANET_SYMBOLS:
+            return self.PLANET_SYMBOLS[name]
+        
+        for sign in ZodiacCalculator.ZODIAC_SIGNS:
+            if sign['name'] == name:
+                return sign['symbol']
+        
+        aspect_info = {**AspectsCalculator.MAJOR_ASPECTS, **AspectsCalculator.MINOR_ASPECTS}.get(name)
+        if aspect_info is not None:
+            return aspect_info['symbol']
+        
+        return name
+    
+    def format_label(self, name: str, use_glyph: bool = False) -> str:
+        """
+        Format a planet, sign or aspect name for display.
+        
+        Args:
+            name: Name of a planet, zodiac sign or aspect
+            use_glyph: Whether to show the glyph instead of the name
+            
+        Returns:
+            Display label
+        """
+        return self.get_glyph(name) if use_glyph else name
     
     def format_planetary_table(self, planetary_data: pd.DataFrame) -> pd.DataFrame:
         """
@@ -135,11 +220,32 @@ class VisualizationHelper:
         
         return formatted_df[display_columns]
     
+    def get_solar_condition(self, planet_longitude: float, sun_longitude: float) -> Optional[str]:
+        """
+        Classify a planet's condition relative to the Sun.
+        
+        Args:
+            planet_longitude: Planet's ecliptic longitude in degrees
+            sun_longitude: Sun's ecliptic longitude in degrees
+            
+        Returns:
+            'Cazimi' within 17 arcminutes of the Sun, 'Combust' within 8.5°,
+            or None if the planet is clear of the Sun
+        """
+        distance = angular_separation(planet_longitude, sun_longitude)
+        
+        if distance <= 17 / 60:
+            return 'Cazimi'
+        elif distance <= 8.5:
+            return 'Combust'
+        return None
+    
     def create_natal_chart(self, 
                           planetary_data: pd.DataFrame,
                           house_cusps: Optional[Dict[int, float]] = None,
                           aspects_data: Optional[pd.DataFrame] = None,
-                          chart_size: Tuple[int, int] = (12, 12)) -> plt.Figure:
+                          chart_size: Tuple[int, int] = (12, 12),
+                          show_legend: bool = False) -> plt.Figure:
         """
         Create a basic natal chart visualization.
         
@@ -148,6 +254,7 @@ class VisualizationHelper:
             house_cusps: Dictionary with house cusps
             aspects_data: DataFrame with aspects
             chart_size: Size of the chart (width, height)
+            show_legend: Whether to draw a legend of planets, aspects and houses
             
         Returns:
             Matplotlib figure object
@@ -190,6 +297,9 @@ class VisualizationHelper:
         
         # Draw planets
         if 'Ecliptic_Longitude' in planetary_data.columns:
+            sun_data = planetary_data[planetary_data['Planet'] == 'Sun']
+            sun_longitude = sun_data.iloc[0]['Ecliptic_Longitude'] if not sun_data.empty else None
+            
             for _, planet in planetary_data.iterrows():
                 angle = (planet['Ecliptic_Longitude'] - 90) * math.pi / 180
                 x = 1.05 * math.cos(angle)
@@ -199,7 +309,14 @@ class VisualizationHelper:
                 symbol = self.PLANET_SYMBOLS.get(planet['Planet'], planet['Planet'][:2])
                 color = self.PLANET_COLORS.get(planet['Planet'], 'black')
                 
-                ax.scatter(x, y, s=200, color=color, alpha=0.8, edgecolors='black')
+                # Dim combust planets and highlight cazimi planets
+                condition = None
+                if sun_longitude is not None and planet['Planet'] != 'Sun':
+                    condition = self.get_solar_condition(planet['Ecliptic_Longitude'], sun_longitude)
+                style = self.SOLAR_CONDITION_STYLES[condition]
+                
+                ax.scatter(x, y, s=style['size'], color=color, alpha=style['alpha'],
+                          edgecolors=style['edgecolor'], linewidths=style['linewidth'])
                 ax.text(x, y, symbol, ha='center', va='center', fontsize=12, 
                        color='white', weight='bold')
         
@@ -222,22 +339,135 @@ class VisualizationHelper:
                     x2 = 1.05 * math.cos(angle2)
                     y2 = 1.05 * math.sin(angle2)
                     
-                    # Color based on aspect nature
-                    if aspect['nature'] == 'Harmonious':
-                        color = 'blue'
-                        alpha = 0.6
-                    elif aspect['nature'] == 'Challenging':
-                        color = 'red'
-                        alpha = 0.6
-                    else:
-                        color = 'gray'
-                        alpha = 0.4
+                    color, alpha = self.get_aspect_color(aspect['aspect'], aspect['nature'])
                     
                     ax.plot([x1, x2], [y1, y2], color=color, alpha=alpha, linewidth=1)
         
+        if show_legend:
+            self._draw_legend(ax, planetary_data, aspects_data, house_cusps)
+        
         plt.title('Natal Chart', fontsize=16, weight='bold', pad=20)
         return fig
     
+    def _draw_legend(self,
+                     ax: plt.Axes,
+                     planetary_data: pd.DataFrame,
+                     aspects_data: Optional[pd.DataFrame] = None,
+                     house_cusps: Optional[Dict[int, float]] = None) -> None:
+        """
+        Draw a legend explaining the planet glyphs, aspect colors and houses.
+        
+        Args:
+            ax: Axes of the natal chart
+            planetary_data: DataFrame with planetary positions
+            aspects_data: DataFrame with aspects (optional)
+            house_cusps: Dictionary with house cusps (optional)
+        """
+        handles = []
+        
+        for planet in planetary_data['Planet']:
+            symbol = self.PLANET_SYMBOLS.get(planet, planet[:2])
+            handles.append(Line2D([], [], linestyle='none', marker='o', markersize=10,
+                                  color=self.PLANET_COLORS.get(planet, 'black'),
+                                  label=f"{symbol} {planet}"))
+        
+        if aspects_data is not None and not aspects_data.empty:
+            aspect_types = aspects_data[['aspect', 'symbol', 'nature']].drop_duplicates('aspect')
+            for _, aspect in aspect_types.iterrows():
+                color, alpha = self.get_aspect_color(aspect['aspect'], aspect['nature'])
+                handles.append(Line2D([], [], color=color, alpha=alpha,
+                                      label=f"{aspect['symbol']} {aspect['aspect']}"))
+        
+        ax.legend(handles=handles, loc='upper left', fontsize=9, title='Legend')
+        
+        if house_cusps:
+            house_lines = [f"{num}: {info['name']}"
+                           for num, info in HousesCalculator.HOUSE_MEANINGS.items()]
+            ax.text(1.5, -1.5, '\n'.join(house_lines), ha='right', va='bottom', fontsize=8,
+                    bbox={'boxstyle': 'round', 'facecolor': 'white', 'alpha': 0.8})
+    
+    def create_chart_sequence(self,
+                              planetary_data_list: List[pd.DataFrame],
+                              house_cusps_list: Optional[List[Optional[Dict[int, float]]]] = None,
+                              aspects_data_list: Optional[List[Optional[pd.DataFrame]]] = None,
+                              chart_size: Tuple[int, int] = (12, 12),
+                              rasterize: bool = False) -> List[Union[plt.Figure, Image.Image]]:
+        """
+        Create a series of natal chart frames with consistent sizing.
+        
+        Args:
+            planetary_data_list: One DataFrame of planetary positions per frame
+            house_cusps_list: House cusps per frame (optional)
+            aspects_data_list: Aspects per frame (optional)
+            chart_size: Size of every chart (width, height)
+            rasterize: Whether to render each frame to an RGB image and close its
+                      figure straight away, so long sequences don't keep every
+                      figure open
+            
+        Returns:
+            List of Matplotlib figure objects, or PIL images if rasterize is
+            True, one per frame
+        """
+        frame_count = len(planetary_data_list)
+        house_cusps_list = house_cusps_list or [None] * frame_count
+        aspects_data_list = aspects_data_list or [None] * frame_count
+        
+        if len(house_cusps_list) != frame_count or len(aspects_data_list) != frame_count:
+            raise ValueError("House cusps and aspects lists must match the number of frames")
+        
+        frames = []
+        for planetary_data, house_cusps, aspects_data in zip(
+                planetary_data_list, house_cusps_list, aspects_data_list):
+            fig = self.create_natal_chart(planetary_data, house_cusps, aspects_data, chart_size)
+            frames.append(self._rasterize_figure(fig) if rasterize else fig)
+        
+        return frames
+    
+    def save_animation(self,
+                       frames: List[Union[plt.Figure, Image.Image]],
+                       filename: str,
+                       delay_ms: int = 200,
+                       close_figures: bool = True) -> None:
+        """
+        Save a sequence of chart frames as a looping animated GIF.
+        
+        Args:
+            frames: Figures or images to use as frames (e.g. from create_chart_sequence)
+            filename: Output GIF filename
+            delay_ms: Delay between frames in milliseconds
+            close_figures: Whether to close each figure once it has been rasterized
+        """
+        if not frames:
+            raise ValueError("At least one frame is required")
+        
+        images = [
+            frame.convert('RGB') if isinstance(frame, Image.Image)
+            else self._rasterize_figure(frame, close_figures)
+            for frame in frames
+        ]
+        
+        images[0].save(filename, format='GIF', save_all=True, append_images=images[1:],
+                       duration=delay_ms, loop=0)
+    
+    def _rasterize_figure(self, fig: plt.Figure, close: bool = True) -> Image.Image:
+        """
+        Render a figure to an RGB image.
+        
+        Args:
+            fig: Matplotlib figure to render
+            close: Whether to close the figure afterwards
+            
+        Returns:
+            PIL image of the rendered figure
+        """
+        fig.canvas.draw()
+        image = Image.fromarray(np.asarray(fig.canvas.buffer_rgba())).convert('RGB')
+        
+        if close:
+            plt.close(fig)
+        
+        return image
+    
     def create_planetary_positions_chart(self, planetary_data: pd.DataFrame) -> plt.Figure:
         """
         Create a chart showing planetary positions across zodiac signs.
@@ -375,6 +605,120 @@ class VisualizationHelper:
         
         return summary
     
+    def save_chart_image(self,
+                         fig: plt.Figure,
+                         filename: str,
+                         planetary_data: Optional[pd.DataFrame] = None,
+                         aspects_data: Optional[pd.DataFrame] = None,
+                         house_cusps: Optional[Dict[int, float]] = None,
+                         location: Optional[Union[str, Dict[str, float]]] = None) -> None:
+        """
+        Save a chart figure as PNG, optionally embedding the chart data.
+        
+        The planetary positions, aspects and house cusps are stored as JSON in
+        a tEXt chunk together with the chart's date (taken from the 'Date'
+        column) and location, keeping numbers and house numbers typed.
+        
+        Args:
+            fig: Matplotlib figure to save
+            filename: Output PNG filename
+            planetary_data: DataFrame with planetary positions to embed (optional)
+            aspects_data: DataFrame with aspects to embed (optional)
+            house_cusps: Dictionary with house cusps to embed (optional)
+            location: Location the chart was cast for (optional)
+        """
+        metadata = None
+        if planetary_data is not None:
+            date = None
+            if 'Date' in planetary_data.columns and not planetary_data.empty:
+                date = self._to_json_value(planetary_data['Date'].iloc[0])
+            
+            chart_data = {
+                'date': date,
+                'location': location,
+                'planetary_positions': self._records_to_json(planetary_data),
+                'aspects': self._records_to_json(aspects_data) if aspects_data is not None else None,
+                'house_cusps': {
+                    str(house_num): float(cusp) for house_num, cusp in (house_cusps or {}).items()
+                }
+            }
+            metadata = {self.CHART_DATA_KEY: json.dumps(chart_data, default=str)}
+        
+        fig.savefig(filename, format='png', metadata=metadata)
+    
+    def load_chart_data_from_image(self, filename: str) -> Dict[str, any]:
+        """
+        Load the chart data embedded in a PNG saved by save_chart_image.
+        
+        Args:
+            filename: PNG filename
+            
+        Returns:
+            Dictionary with 'date', 'location', 'planetary_data' (DataFrame),
+            'aspects_data' (DataFrame or None) and 'house_cusps' (keyed by
+            house number)
+            
+        Raises:
+            ValueError: If the file is not a PNG or contains no chart data
+        """
+        try:
+            with Image.open(filename) as image:
+                is_png = image.format == 'PNG'
+                text = image.text.get(self.CHART_DATA_KEY) if is_png else None
+        except OSError as e:
+            raise ValueError(f"{filename} is not an image file: {e}")
+        
+        if not is_png:
+            raise ValueError(f"{filename} is not a PNG file")
+        if text is None:
+            raise ValueError(f"No chart data found in {filename}")
+        
+        chart_data = json.loads(text)
+        aspects = chart_data['aspects']
+        
+        return {
+            'date': chart_data['date'],
+            'location': chart_data['location'],
+            'planetary_data': pd.DataFrame(chart_data['planetary_positions']),
+            'aspects_data': pd.DataFrame(aspects) if aspects is not None else None,
+            'house_cusps': {
+                int(house_num): cusp
+                for house_num, cusp in chart_data['house_cusps'].items()
+            }
+        }
+    
+    def _records_to_json(self, data: pd.DataFrame) -> List[Dict[str, any]]:
+        """
+        Convert DataFrame rows to records of JSON-native values.
+        
+        Args:
+            data: DataFrame to convert
+            
+        Returns:
+            List of row dictionaries
+        """
+        return [
+            {column: self._to_json_value(value) for column, value in record.items()}
+            for record in data.to_dict('records')
+        ]
+    
+    def _to_json_value(self, value: any) -> any:
+        """
+        Convert a numpy scalar or timestamp to its JSON-native equivalent.
+        
+        Args:
+            value: Value to convert
+            
+        Returns:
+            Python int, float or bool for numpy scalars, an ISO string for
+            dates, and the value unchanged otherwise
+        """
+        if isinstance(value, np.generic):
+            return value.item()
+        if hasattr(value, 'isoformat'):
+            return value.isoformat()
+        return value
+    
     def create_ephemeris_plot(self, 
                             ephemeris_data: pd.DataFrame,
                             planet: str = 'Sun') -> plt.Figure:
@@ -9,8 +9,11 @@ from typing import Dict, List, Optional, Tuple
 import numpy as np
 import pandas as pd
 from datetime import datetime
-from astropy.coordinates import SkyCoord
+from astropy.coordinates import SkyCoord, FK4, FK5, GCRS, TETE, GeocentricTrueEcliptic
 from astropy import units as u
+from astropy.time import Time
+
+from .coordinates import angular_separation
 
 
 class ZodiacCalculator:
@@ -37,29 +40,251 @@ class ZodiacCalculator:
         {'name': 'Pisces', 'symbol': '♓', 'element': 'Water', 'quality': 'Mutable', 'ruler': 'Jupiter'}
     ]
     
+    # Polarity of each element (Fire/Air positive, Earth/Water negative)
+    ELEMENT_POLARITY = {
+        'Fire': 'Yang',
+        'Air': 'Yang',
+        'Earth': 'Yin',
+        'Water': 'Yin'
+    }
+    
+    # Sign of exaltation for each traditional planet
+    EXALTATIONS = {
+        'Sun': 'Aries',
+        'Moon': 'Taurus',
+        'Mercury': 'Virgo',
+        'Venus': 'Pisces',
+        'Mars': 'Capricorn',
+        'Jupiter': 'Cancer',
+        'Saturn': 'Libra'
+    }
+    
+    # Triplicity rulers by element as (day, night, participating), after Dorotheus
+    TRIPLICITY_RULERS = {
+        'Fire': ('Sun', 'Jupiter', 'Saturn'),
+        'Earth': ('Venus', 'Moon', 'Mars'),
+        'Air': ('Saturn', 'Mercury', 'Jupiter'),
+        'Water': ('Venus', 'Mars', 'Moon')
+    }
+    
+    # Egyptian terms for each sign as (end degree, ruler) pairs
+    TERMS = {
+        'Aries': [(6, 'Jupiter'), (12, 'Venus'), (20, 'Mercury'), (25, 'Mars'), (30, 'Saturn')],
+        'Taurus': [(8, 'Venus'), (14, 'Mercury'), (22, 'Jupiter'), (27, 'Saturn'), (30, 'Mars')],
+        'Gemini': [(6, 'Mercury'), (12, 'Jupiter'), (17, 'Venus'), (24, 'Mars'), (30, 'Saturn')],
+        'Cancer': [(7, 'Mars'), (13, 'Venus'), (19, 'Mercury'), (26, 'Jupiter'), (30, 'Saturn')],
+        'Leo': [(6, 'Jupiter'), (11, 'Venus'), (18, 'Saturn'), (24, 'Mercury'), (30, 'Mars')],
+        'Virgo': [(7, 'Mercury'), (17, 'Venus'), (21, 'Jupiter'), (28, 'Mars'), (30, 'Saturn')],
+        'Libra': [(6, 'Saturn'), (14, 'Mercury'), (21, 'Jupiter'), (28, 'Venus'), (30, 'Mars')],
+        'Scorpio': [(7, 'Mars'), (11, 'Venus'), (19, 'Mercury'), (24, 'Jupiter'), (30, 'Saturn')],
+        'Sagittarius': [(12, 'Jupiter'), (17, 'Venus'), (21, 'Mercury'), (26, 'Saturn'), (30, 'Mars')],
+        'Capricorn': [(7, 'Mercury'), (14, 'Jupiter'), (22, 'Venus'), (26, 'Saturn'), (30, 'Mars')],
+        'Aquarius': [(7, 'Mercury'), (13, 'Venus'), (20, 'Jupiter'), (25, 'Mars'), (30, 'Saturn')],
+        'Pisces': [(12, 'Venus'), (16, 'Jupiter'), (19, 'Mercury'), (28, 'Mars'), (30, 'Saturn')]
+    }
+    
+    # Planets in Chaldean order, which assigns the faces from Mars at 0° Aries
+    CHALDEAN_ORDER = ['Saturn', 'Jupiter', 'Mars', 'Sun', 'Venus', 'Mercury', 'Moon']
+    
+    # Essential dignity points (after William Lilly)
+    DIGNITY_SCORES = {
+        'rulership': 5,
+        'exaltation': 4,
+        'triplicity': 3,
+        'term': 2,
+        'face': 1,
+        'detriment': -5,
+        'fall': -4
+    }
+    
+    # Bonus for a planet aspecting the ruler of the sign it occupies
+    DISPOSITOR_ASPECT_BONUS = 2
+    
+    # Mean length of the lunation cycle in days
+    SYNODIC_MONTH = 29.530588853
+    
+    # Arabic parts as (points added, points subtracted) to the Ascendant
+    ARABIC_PARTS = {
+        'Fortune': (['Moon'], ['Sun']),
+        'Spirit': (['Sun'], ['Moon']),
+        'Marriage': (['Descendant'], ['Venus']),
+        'Children': (['Jupiter'], ['Saturn']),
+        'Father': (['Saturn'], ['Sun']),
+        'Mother': (['Moon'], ['Venus'])
+    }
+    
+    # Arabic parts whose added and subtracted points swap in a night chart
+    NIGHT_REVERSED_PARTS = ('Fortune', 'Spirit', 'Children', 'Father', 'Mother')
+    
+    # Minor years of each sign used for zodiacal releasing (after Vettius Valens)
+    RELEASING_YEARS = {
+        'Aries': 15, 'Taurus': 8, 'Gemini': 20, 'Cancer': 25,
+        'Leo': 19, 'Virgo': 20, 'Libra': 8, 'Scorpio': 15,
+        'Sagittarius': 12, 'Capricorn': 27, 'Aquarius': 30, 'Pisces': 12
+    }
+    
+    # Lunar phases, each spanning 45° of Sun-Moon elongation from the New Moon
+    LUNAR_PHASES = [
+        'New Moon', 'Waxing Crescent', 'First Quarter', 'Waxing Gibbous',
+        'Full Moon', 'Waning Gibbous', 'Last Quarter', 'Waning Crescent'
+    ]
+    
     def __init__(self):
         """Initialize the ZodiacCalculator."""
-        pass
+        self.reference_offset = 0.0
     
-    def ra_dec_to_ecliptic(self, ra: float, dec: float, epoch: str = 'J2000') -> Tuple[float, float]:
+    def set_reference_offset(self, degrees: float):
+        """
+        Set an offset between the ecliptic and zodiac reference points.
+        
+        The offset is subtracted from ecliptic longitudes before they are
+        mapped to signs, so a value such as an ayanamsa gives sidereal positions.
+        
+        Args:
+            degrees: Offset in degrees (0 for the tropical zodiac)
+        """
+        self.reference_offset = degrees % 360
+    
+    def to_zodiac_longitude(self, ecliptic_longitude: float) -> float:
+        """
+        Apply the reference offset to an ecliptic longitude.
+        
+        Args:
+            ecliptic_longitude: Ecliptic longitude in degrees
+            
+        Returns:
+            Zodiacal longitude in degrees (0-360)
+        """
+        return (ecliptic_longitude - self.reference_offset) % 360
+    
+    def ra_dec_to_ecliptic(self,
+                           ra: float,
+                           dec: float,
+                           epoch: str = 'J2000',
+                           obstime: Optional[any] = None,
+                           apparent: bool = False) -> Tuple[float, float]:
         """
         Convert Right Ascension and Declination to ecliptic coordinates.
         
+        Without an observation time the result is referred to the J2000
+        ecliptic. With one, it is referred to the true ecliptic and equinox of
+        that date: astrometric coordinates (Horizons 'RA'/'DEC') then give
+        geometric longitudes, and apparent coordinates (Horizons
+        'RA_app'/'DEC_app') give apparent longitudes including aberration and
+        nutation.
+        
         Args:
             ra: Right Ascension in degrees
             dec: Declination in degrees
             epoch: Epoch for the coordinate system (default: J2000)
+            obstime: Time of observation (astropy Time or anything it accepts)
+            apparent: Whether ra/dec are apparent coordinates referred to the
+                     true equator and equinox of obstime
             
         Returns:
             Tuple of (ecliptic_longitude, ecliptic_latitude) in degrees
+            
+        Raises:
+            ValueError: If apparent coordinates are given without an obstime
+        """
+        if obstime is None:
+            if apparent:
+                raise ValueError("Apparent coordinates require an observation time")
+            
+            # Create SkyCoord object
+            coord = SkyCoord(ra=ra*u.degree, dec=dec*u.degree, frame='icrs')
+            
+            # Convert to ecliptic coordinates
+            ecliptic_coord = coord.geocentrictrueecliptic
+            
+            return ecliptic_coord.lon.degree, ecliptic_coord.lat.degree
+        
+        # Astrometric directions are taken as GCRS so no aberration is added;
+        # apparent ones are already on the true equator and equinox of date
+        frame = TETE(obstime=obstime) if apparent else GCRS(obstime=obstime)
+        coord = SkyCoord(ra=ra*u.degree, dec=dec*u.degree, frame=frame)
+        ecliptic_coord = coord.transform_to(GeocentricTrueEcliptic(equinox=obstime, obstime=obstime))
+        
+        return ecliptic_coord.lon.degree % 360, ecliptic_coord.lat.degree
+    
+    def get_ephemeris_longitudes(self,
+                                 ephemeris_data: pd.DataFrame,
+                                 apparent: bool = False) -> List[Tuple[float, float]]:
+        """
+        Get the ecliptic longitude at each sample of an ephemeris time series.
+        
+        By default the astrometric 'RA'/'DEC' are converted to the J2000
+        ecliptic, like calculate_zodiac_positions. With apparent=True the
+        apparent 'RA_app'/'DEC_app' are used instead, giving apparent
+        longitudes referred to the equinox of each date; samples without
+        apparent coordinates are skipped.
+        
+        Args:
+            ephemeris_data: DataFrame from AstroDataFetcher.get_ephemeris_range
+                           (must have 'datetime_jd' and the 'RA'/'DEC' or
+                           'RA_app'/'DEC_app' columns)
+            apparent: Whether to use the apparent coordinates of date
+            
+        Returns:
+            List of (julian_day, ecliptic_longitude) tuples in time order
+        """
+        ra_col, dec_col = ('RA_app', 'DEC_app') if apparent else ('RA', 'DEC')
+        if any(col not in ephemeris_data.columns for col in ('datetime_jd', ra_col, dec_col)):
+            raise ValueError(f"DataFrame must contain 'datetime_jd', '{ra_col}' and '{dec_col}' columns")
+        
+        if apparent:
+            ephemeris_data = ephemeris_data[ephemeris_data[ra_col].notna() &
+                                            ephemeris_data[dec_col].notna()]
+        if ephemeris_data.empty:
+            return []
+        
+        # Convert the whole series at once; a minute-step refinement window
+        # has over a thousand rows
+        julian_days = ephemeris_data['datetime_jd'].values
+        if apparent:
+            obstime = Time(julian_days, format='jd', scale='utc')
+            ecl_lon, _ = self.ra_dec_to_ecliptic(ephemeris_data[ra_col].values,
+                                                 ephemeris_data[dec_col].values,
+                                                 obstime=obstime, apparent=True)
+        else:
+            ecl_lon, _ = self.ra_dec_to_ecliptic(ephemeris_data[ra_col].values,
+                                                 ephemeris_data[dec_col].values)
+        
+        return list(zip(julian_days, ecl_lon))
+    
+    def precess_to_b1950(self, ra: float, dec: float, equinox: str = 'J2000') -> Tuple[float, float]:
         """
-        # Create SkyCoord object
-        coord = SkyCoord(ra=ra*u.degree, dec=dec*u.degree, frame='icrs')
+        Convert equatorial coordinates to the B1950 (FK4) frame.
         
-        # Convert to ecliptic coordinates
-        ecliptic_coord = coord.geocentrictrueecliptic
+        Args:
+            ra: Right Ascension in degrees
+            dec: Declination in degrees
+            equinox: Equinox of the input FK5 coordinates (default: J2000)
+            
+        Returns:
+            Tuple of (ra, dec) in degrees referred to the B1950 equinox
+        """
+        coord = SkyCoord(ra=ra*u.degree, dec=dec*u.degree, frame=FK5(equinox=equinox))
+        b1950_coord = coord.transform_to(FK4(equinox='B1950'))
         
-        return ecliptic_coord.lon.degree, ecliptic_coord.lat.degree
+        return b1950_coord.ra.degree, b1950_coord.dec.degree
+    
+    def precess_from_b1950(self, ra: float, dec: float, equinox: str = 'J2000') -> Tuple[float, float]:
+        """
+        Convert B1950 (FK4) equatorial coordinates to the FK5 frame.
+        
+        Args:
+            ra: Right Ascension in degrees referred to the B1950 equinox
+            dec: Declination in degrees referred to the B1950 equinox
+            equinox: Equinox of the output FK5 coordinates (default: J2000)
+            
+        Returns:
+            Tuple of (ra, dec) in degrees
+        """
+        coord = SkyCoord(ra=ra*u.degree, dec=dec*u.degree, frame=FK4(equinox='B1950'))
+        fk5_coord = coord.transform_to(FK5(equinox=equinox))
+        
+        return fk5_coord.ra.degree, fk5_coord.dec.degree
     
     def ecliptic_to_zodiac(self, ecliptic_longitude: float) -> Dict[str, any]:
         """
@@ -71,8 +296,8 @@ class ZodiacCalculator:
         Returns:
             Dictionary with zodiac information including sign, degree, symbol, etc.
         """
-        # Normalize longitude to 0-360 range
-        longitude = ecliptic_longitude % 360
+        # Normalize longitude to 0-360 range in the reference frame
+        longitude = self.to_zodiac_longitude(ecliptic_longitude)
         
         # Calculate zodiac sign (each sign is 30 degrees)
         sign_index = int(longitude // 30)
@@ -90,6 +315,26 @@ class ZodiacCalculator:
         
         return zodiac_info
     
+    def zodiac_to_ecliptic(self, sign_name: str, degree: float) -> float:
+        """
+        Convert a zodiac sign and degree back to ecliptic longitude.
+        
+        Args:
+            sign_name: Name of the zodiac sign
+            degree: Degree within the sign (0-30)
+            
+        Returns:
+            Ecliptic longitude in degrees (0-360)
+            
+        Raises:
+            ValueError: If the sign name is unknown
+        """
+        for sign_index, sign in enumerate(self.ZODIAC_SIGNS):
+            if sign['name'].lower() == sign_name.lower():
+                return (sign_index * 30 + degree + self.reference_offset) % 360
+        
+        raise ValueError(f"Unknown zodiac sign: {sign_name}")
+    
     def calculate_zodiac_positions(self, planetary_data: pd.DataFrame) -> pd.DataFrame:
         """
         Calculate zodiac positions for planetary data.
@@ -133,6 +378,95 @@ class ZodiacCalculator:
         
         return result_df
     
+    def find_sign_ingresses(self, ephemeris_data: pd.DataFrame) -> List[Dict[str, any]]:
+        """
+        Find every sign change in an ephemeris time series.
+        
+        Ingress times are linearly interpolated between samples, so the step
+        of the ephemeris should be small enough that a planet never crosses
+        more than one sign boundary between samples (e.g. '1d'). Longitudes
+        come from get_ephemeris_longitudes.
+        
+        Args:
+            ephemeris_data: DataFrame from AstroDataFetcher.get_ephemeris_range
+                           (must have 'datetime_jd', 'RA' and 'DEC' columns)
+            
+        Returns:
+            List of dictionaries with the Julian Day, the sign entered and the
+            direction of motion for each ingress
+        """
+        samples = [
+            (julian_day, self.to_zodiac_longitude(ecl_lon))
+            for julian_day, ecl_lon in self.get_ephemeris_longitudes(ephemeris_data)
+        ]
+        
+        ingresses = []
+        for (jd1, lon1), (jd2, lon2) in zip(samples, samples[1:]):
+            sign1 = int(lon1 // 30)
+            sign2 = int(lon2 // 30)
+            if sign1 == sign2:
+                continue
+            
+            # Signed motion between samples, taking the short way round
+            motion = (lon2 - lon1 + 180) % 360 - 180
+            if motion == 0:
+                continue
+            direct = motion > 0
+            boundary = sign2 * 30 if direct else sign1 * 30
+            fraction = ((boundary - lon1 + 180) % 360 - 180) / motion
+            
+            ingresses.append({
+                'jd': jd1 + fraction * (jd2 - jd1),
+                'sign': self.ZODIAC_SIGNS[sign2]['name'],
+                'symbol': self.ZODIAC_SIGNS[sign2]['symbol'],
+                'direction': 'Direct' if direct else 'Retrograde'
+            })
+        
+        return ingresses
+    
+    def find_singletons(self, planetary_data: pd.DataFrame) -> Dict[str, List[str]]:
+        """
+        Find planets that are the sole occupant of an element or polarity.
+        
+        Args:
+            planetary_data: DataFrame with planetary positions
+            
+        Returns:
+            Dictionary keyed by category (e.g. 'element:Water', 'polarity:Yin')
+            listing the singleton planet for each category that has one
+        """
+        if 'Ecliptic_Longitude' not in planetary_data.columns:
+            raise ValueError("DataFrame must contain 'Ecliptic_Longitude' column")
+        
+        groups = {}
+        for _, row in planetary_data.iterrows():
+            element = self.ecliptic_to_zodiac(row['Ecliptic_Longitude'])['element']
+            for category in (f"element:{element}", f"polarity:{self.ELEMENT_POLARITY[element]}"):
+                groups.setdefault(category, []).append(row['Planet'])
+        
+        return {category: planets for category, planets in groups.items() if len(planets) == 1}
+    
+    def calculate_polarity_balance(self, planetary_data: pd.DataFrame) -> Tuple[int, int]:
+        """
+        Count planets in positive (Fire/Air) and negative (Earth/Water) signs.
+        
+        Args:
+            planetary_data: DataFrame with planetary positions
+            
+        Returns:
+            Tuple of (positive, negative) planet counts
+        """
+        if 'Ecliptic_Longitude' not in planetary_data.columns:
+            raise ValueError("DataFrame must contain 'Ecliptic_Longitude' column")
+        
+        positive = 0
+        for longitude in planetary_data['Ecliptic_Longitude']:
+            element = self.ecliptic_to_zodiac(longitude)['element']
+            if self.ELEMENT_POLARITY[element] == 'Yang':
+                positive += 1
+        
+        return positive, len(planetary_data) - positive
+    
     def get_zodiac_sign_info(self, sign_name: str) -> Optional[Dict[str, any]]:
         """
         Get detailed information about a zodiac sign.
@@ -148,6 +482,475 @@ class ZodiacCalculator:
                 return sign.copy()
         return None
     
+    def get_opposite_sign(self, sign_name: str) -> Optional[Dict[str, any]]:
+        """
+        Get the zodiac sign opposite (180° from) a given sign.
+        
+        Args:
+            sign_name: Name of the zodiac sign
+            
+        Returns:
+            Dictionary with the opposite sign's information, or None if not found
+        """
+        for sign_index, sign in enumerate(self.ZODIAC_SIGNS):
+            if sign['name'].lower() == sign_name.lower():
+                return self.ZODIAC_SIGNS[(sign_index + 6) % 12].copy()
+        return None
+    
+    def calculate_annual_profection(self, ascendant_sign: str, age: int) -> Dict[str, any]:
+        """
+        Profect the Ascendant by one sign per year of life.
+        
+        Args:
+            ascendant_sign: Name of the Ascendant's sign
+            age: Age in completed years
+            
+        Returns:
+            Dictionary with the profected 'sign', the profected 'house' (1-12)
+            and the 'year_lord' (ruler of the profected sign)
+            
+        Raises:
+            ValueError: If the sign is unknown or the age is negative
+        """
+        if age < 0:
+            raise ValueError(f"Age must not be negative, got {age}")
+        
+        for sign_index, sign in enumerate(self.ZODIAC_SIGNS):
+            if sign['name'].lower() == ascendant_sign.lower():
+                profected = self.ZODIAC_SIGNS[(sign_index + age) % 12]
+                return {
+                    'sign': profected['name'],
+                    'house': age % 12 + 1,
+                    'year_lord': profected['ruler']
+                }
+        
+        raise ValueError(f"Unknown zodiac sign: {ascendant_sign}")
+    
+    def calculate_zodiacal_releasing(self, start_sign: str, total_years: float) -> List[Dict[str, any]]:
+        """
+        Calculate the first-level zodiacal releasing periods from a lot's sign.
+        
+        Each sign in turn rules a period lasting its minor years, starting
+        from the sign of the lot (e.g. Fortune or Spirit).
+        
+        Args:
+            start_sign: Name of the sign the releasing starts from
+            total_years: Span of life to cover in years
+            
+        Returns:
+            List of dictionaries with 'sign', 'ruler', 'years', 'start_year'
+            and 'end_year' for each period beginning within the span
+            
+        Raises:
+            ValueError: If the sign is unknown
+        """
+        sign_names = [sign['name'] for sign in self.ZODIAC_SIGNS]
+        matches = [i for i, name in enumerate(sign_names) if name.lower() == start_sign.lower()]
+        if not matches:
+            raise ValueError(f"Unknown zodiac sign: {start_sign}")
+        
+        periods = []
+        sign_index = matches[0]
+        start_year = 0.0
+        while start_year < total_years:
+            sign = self.ZODIAC_SIGNS[sign_index]
+            years = self.RELEASING_YEARS[sign['name']]
+            periods.append({
+                'sign': sign['name'],
+                'ruler': sign['ruler'],
+                'years': years,
+                'start_year': start_year,
+                'end_year': start_year + years
+            })
+            start_year += years
+            sign_index = (sign_index + 1) % 12
+        
+        return periods
+    
+    def calculate_dwad(self, longitude: float) -> Dict[str, any]:
+        """
+        Get the dwad (dwadashamsha) sub-sign of an ecliptic longitude.
+        
+        Each sign is divided into twelve 2.5° parts, the first belonging to
+        the sign itself and the rest following in zodiacal order.
+        
+        Args:
+            longitude: Ecliptic longitude in degrees
+            
+        Returns:
+            Dictionary with information about the dwad sign
+        """
+        longitude = self.to_zodiac_longitude(longitude)
+        sign_index = int(longitude // 30)
+        dwad_index = int((longitude % 30) // 2.5)
+        
+        return self.ZODIAC_SIGNS[(sign_index + dwad_index) % 12].copy()
+    
+    def get_decan_ruler(self, longitude: float) -> str:
+        """
+        Get the ruler of the decan (10° third of a sign) at a position.
+        
+        Decans follow the triplicity scheme: the first is ruled by the sign's
+        own ruler, the second and third by the rulers of the following signs
+        of the same element.
+        
+        Args:
+            longitude: Ecliptic longitude in degrees
+            
+        Returns:
+            Name of the decan ruler
+        """
+        longitude = self.to_zodiac_longitude(longitude)
+        sign_index = int(longitude // 30)
+        decan_index = int((longitude % 30) // 10)
+        
+        return self.ZODIAC_SIGNS[(sign_index + 4 * decan_index) % 12]['ruler']
+    
+    def calculate_decan_rulers(self, planetary_data: pd.DataFrame) -> Dict[str, str]:
+        """
+        Get the decan ruler of every planet in a chart.
+        
+        Args:
+            planetary_data: DataFrame with planetary positions
+            
+        Returns:
+            Dictionary mapping planet names to their decan rulers
+        """
+        if 'Ecliptic_Longitude' not in planetary_data.columns:
+            raise ValueError("DataFrame must contain 'Ecliptic_Longitude' column")
+        
+        return {
+            row['Planet']: self.get_decan_ruler(row['Ecliptic_Longitude'])
+            for _, row in planetary_data.iterrows()
+        }
+    
+    def get_chart_ruler(self, ascendant: float) -> str:
+        """
+        Get the chart ruler (the ruler of the Ascendant's sign).
+        
+        Args:
+            ascendant: Ascendant position in degrees
+            
+        Returns:
+            Name of the ruling planet
+        """
+        return self.ecliptic_to_zodiac(ascendant)['ruler']
+    
+    def get_essential_dignities(self, planet: str, longitude: float) -> List[str]:
+        """
+        List the essential dignities and debilities of a planet at a position.
+        
+        Args:
+            planet: Planet name
+            longitude: Ecliptic longitude in degrees
+            
+        Returns:
+            List of dignity names ('rulership', 'exaltation', 'detriment', 'fall')
+        """
+        sign_index = int(self.to_zodiac_longitude(longitude) // 30)
+        sign = self.ZODIAC_SIGNS[sign_index]
+        opposite = self.ZODIAC_SIGNS[(sign_index + 6) % 12]
+        
+        dignities = []
+        if sign['ruler'] == planet:
+            dignities.append('rulership')
+        if self.EXALTATIONS.get(planet) == sign['name']:
+            dignities.append('exaltation')
+        if opposite['ruler'] == planet:
+            dignities.append('detriment')
+        if self.EXALTATIONS.get(planet) == opposite['name']:
+            dignities.append('fall')
+        
+        return dignities
+    
+    def get_triplicity_ruler(self, longitude: float, is_day: bool = True) -> str:
+        """
+        Get the triplicity ruler of the sign at a position.
+        
+        Args:
+            longitude: Ecliptic longitude in degrees
+            is_day: Whether the chart is a day chart (Sun above the horizon)
+            
+        Returns:
+            Name of the triplicity ruler
+        """
+        day_ruler, night_ruler, _ = self.TRIPLICITY_RULERS[self.ecliptic_to_zodiac(longitude)['element']]
+        
+        return day_ruler if is_day else night_ruler
+    
+    def get_triplicity_lord_sequence(self, sign_name: str, is_day: bool = True) -> List[str]:
+        """
+        Get the triplicity lords of a sign in the order they govern life.
+        
+        The lord of the chart's sect comes first, then the other sect's
+        lord, then the participating lord.
+        
+        Args:
+            sign_name: Name of the sign (usually that of the sect light)
+            is_day: Whether the chart is a day chart (Sun above the horizon)
+            
+        Returns:
+            List of the three triplicity lords in sequence
+            
+        Raises:
+            ValueError: If the sign is unknown
+        """
+        sign = self.get_zodiac_sign_info(sign_name)
+        if sign is None:
+            raise ValueError(f"Unknown zodiac sign: {sign_name}")
+        
+        day_ruler, night_ruler, participating = self.TRIPLICITY_RULERS[sign['element']]
+        if is_day:
+            return [day_ruler, night_ruler, participating]
+        return [night_ruler, day_ruler, participating]
+    
+    def get_term_ruler(self, longitude: float) -> str:
+        """
+        Get the ruler of the Egyptian term at a position.
+        
+        Args:
+            longitude: Ecliptic longitude in degrees
+            
+        Returns:
+            Name of the term ruler
+        """
+        zodiac_info = self.ecliptic_to_zodiac(longitude)
+        for end_degree, ruler in self.TERMS[zodiac_info['name']]:
+            if zodiac_info['degree'] < end_degree:
+                return ruler
+        
+        return self.TERMS[zodiac_info['name']][-1][1]
+    
+    def get_face_ruler(self, longitude: float) -> str:
+        """
+        Get the ruler of the face (Chaldean decan) at a position.
+        
+        Args:
+            longitude: Ecliptic longitude in degrees
+            
+        Returns:
+            Name of the face ruler
+        """
+        face_index = int(self.to_zodiac_longitude(longitude) // 10)
+        start = self.CHALDEAN_ORDER.index('Mars')
+        
+        return self.CHALDEAN_ORDER[(start + face_index) % len(self.CHALDEAN_ORDER)]
+    
+    def calculate_almuten(self, longitude: float, is_day: bool = True) -> str:
+        """
+        Find the almuten, the planet with the most essential dignity at a degree.
+        
+        Rulership, exaltation, triplicity, term and face points are summed for
+        each traditional planet; ties go to the planet listed first in
+        EXALTATIONS.
+        
+        Args:
+            longitude: Ecliptic longitude in degrees
+            is_day: Whether the chart is a day chart (Sun above the horizon)
+            
+        Returns:
+            Name of the almuten
+        """
+        zodiac_info = self.ecliptic_to_zodiac(longitude)
+        rulers = {
+            'rulership': zodiac_info['ruler'],
+            'triplicity': self.get_triplicity_ruler(longitude, is_day),
+            'term': self.get_term_ruler(longitude),
+            'face': self.get_face_ruler(longitude)
+        }
+        
+        scores = {}
+        for planet, exaltation_sign in self.EXALTATIONS.items():
+            score = sum(self.DIGNITY_SCORES[dignity]
+                        for dignity, ruler in rulers.items() if ruler == planet)
+            if exaltation_sign == zodiac_info['name']:
+                score += self.DIGNITY_SCORES['exaltation']
+            scores[planet] = score
+        
+        return max(scores, key=scores.get)
+    
+    def calculate_essential_dignity(self, planet: str, longitude: float) -> int:
+        """
+        Score a planet's essential dignity at a position.
+        
+        Args:
+            planet: Planet name
+            longitude: Ecliptic longitude in degrees
+            
+        Returns:
+            Essential dignity score (positive is dignified, negative debilitated)
+        """
+        return sum(self.DIGNITY_SCORES[dignity]
+                   for dignity in self.get_essential_dignities(planet, longitude))
+    
+    def calculate_full_dignity(self,
+                               planet: str,
+                               longitude: float,
+                               aspects_df: Optional[pd.DataFrame] = None) -> int:
+        """
+        Score a planet's dignity including reception by its dispositor.
+        
+        A planet in its own face and a planet that aspects the ruler of the
+        sign it occupies each gain a bonus on top of its essential dignity.
+        
+        Args:
+            planet: Planet name
+            longitude: Ecliptic longitude in degrees
+            aspects_df: DataFrame with aspects (from AspectsCalculator), optional
+            
+        Returns:
+            Combined dignity score
+        """
+        score = self.calculate_essential_dignity(planet, longitude)
+        if self.get_face_ruler(longitude) == planet:
+            score += self.DIGNITY_SCORES['face']
+        
+        dispositor = self.ecliptic_to_zodiac(longitude)['ruler']
+        if aspects_df is not None and not aspects_df.empty and dispositor != planet:
+            aspects_dispositor = (
+                ((aspects_df['planet1'] == planet) & (aspects_df['planet2'] == dispositor)) |
+                ((aspects_df['planet1'] == dispositor) & (aspects_df['planet2'] == planet))
+            ).any()
+            if aspects_dispositor:
+                score += self.DISPOSITOR_ASPECT_BONUS
+        
+        return score
+    
+    def calculate_antiscion(self, longitude: float) -> float:
+        """
+        Calculate the antiscion (reflection across the Cancer-Capricorn axis).
+        
+        Args:
+            longitude: Ecliptic longitude in degrees
+            
+        Returns:
+            Antiscion longitude in degrees (0-360)
+        """
+        return (180 - longitude) % 360
+    
+    def calculate_contra_antiscion(self, longitude: float) -> float:
+        """
+        Calculate the contra-antiscion (reflection across the Aries-Libra axis).
+        
+        Args:
+            longitude: Ecliptic longitude in degrees
+            
+        Returns:
+            Contra-antiscion longitude in degrees (0-360)
+        """
+        return (360 - longitude) % 360
+    
+    def calculate_antiscia_grid(self,
+                                planetary_data: pd.DataFrame,
+                                orb: float = 1.0) -> pd.DataFrame:
+        """
+        Find which planets fall on another's antiscion or contra-antiscion.
+        
+        Args:
+            planetary_data: DataFrame with planetary positions
+            orb: Maximum distance from the antiscion point in degrees
+            
+        Returns:
+            Symmetric boolean DataFrame indexed by planet name on both axes
+        """
+        if 'Ecliptic_Longitude' not in planetary_data.columns:
+            raise ValueError("DataFrame must contain 'Ecliptic_Longitude' column")
+        
+        planets = planetary_data['Planet'].tolist()
+        positions = planetary_data['Ecliptic_Longitude'].tolist()
+        
+        grid = []
+        for i, pos1 in enumerate(positions):
+            row = []
+            for j, pos2 in enumerate(positions):
+                row.append(i != j and (
+                    angular_separation(self.calculate_antiscion(pos1), pos2) <= orb or
+                    angular_separation(self.calculate_contra_antiscion(pos1), pos2) <= orb
+                ))
+            grid.append(row)
+        
+        return pd.DataFrame(grid, index=planets, columns=planets)
+    
+    def find_final_dispositors(self, positions: Dict[str, float]) -> Tuple[List[str], List[List[str]]]:
+        """
+        Follow dispositor chains to their final dispositors or reception loops.
+        
+        Each planet is disposed of by the ruler of the sign it occupies. A
+        planet in its own sign is a final dispositor; chains that cycle
+        through two or more planets end in a mutual reception loop. Chains
+        reaching a ruler without a position end without either.
+        
+        Args:
+            positions: Dictionary mapping planet names to ecliptic longitudes
+            
+        Returns:
+            Tuple of (final dispositors, loops), each loop listing its members
+            in dispositor order
+        """
+        finals = []
+        loops = []
+        seen_cycles = set()
+        
+        for planet in positions:
+            chain = []
+            current = planet
+            while current in positions and current not in chain:
+                chain.append(current)
+                current = self.ecliptic_to_zodiac(positions[current])['ruler']
+            
+            if current not in chain:
+                continue
+            
+            cycle = chain[chain.index(current):]
+            if frozenset(cycle) in seen_cycles:
+                continue
+            seen_cycles.add(frozenset(cycle))
+            
+            if len(cycle) == 1:
+                finals.append(cycle[0])
+            else:
+                start = cycle.index(min(cycle))
+                loops.append(cycle[start:] + cycle[:start])
+        
+        return finals, loops
+    
+    def is_besieged(self, target: str, positions: Dict[str, float]) -> bool:
+        """
+        Check whether a planet is besieged between Mars and Saturn.
+        
+        A planet is besieged when it lies between the two malefics in the same
+        sign with no other body between them.
+        
+        Args:
+            target: Name of the planet to check
+            positions: Dictionary mapping planet names to ecliptic longitudes
+            
+        Returns:
+            True if the planet is besieged, False otherwise
+        """
+        if target in ('Mars', 'Saturn') or any(
+                name not in positions for name in (target, 'Mars', 'Saturn')):
+            return False
+        
+        target_pos = self.to_zodiac_longitude(positions[target])
+        mars_pos = self.to_zodiac_longitude(positions['Mars'])
+        saturn_pos = self.to_zodiac_longitude(positions['Saturn'])
+        
+        if not int(target_pos // 30) == int(mars_pos // 30) == int(saturn_pos // 30):
+            return False
+        
+        low, high = sorted((mars_pos, saturn_pos))
+        if not low < target_pos < high:
+            return False
+        
+        # Any other body between the malefics breaks the siege
+        for name, longitude in positions.items():
+            if (name not in (target, 'Mars', 'Saturn')
+                    and low < self.to_zodiac_longitude(longitude) < high):
+                return False
+        
+        return True
+    
     def get_zodiac_compatibility(self, sign1: str, sign2: str) -> Dict[str, any]:
         """
         Calculate basic zodiac compatibility based on elements and qualities.
@@ -247,6 +1050,98 @@ class ZodiacCalculator:
         
         return midpoint % 360
     
+    def calculate_arabic_part(self,
+                              ascendant: float,
+                              components: List[float],
+                              subtract: List[float]) -> float:
+        """
+        Calculate an Arabic part (lot) from the Ascendant.
+        
+        Args:
+            ascendant: Ascendant position in degrees
+            components: Longitudes in degrees added to the Ascendant
+            subtract: Longitudes in degrees subtracted from the Ascendant
+            
+        Returns:
+            Longitude of the part in degrees (0-360)
+        """
+        return (ascendant + sum(components) - sum(subtract)) % 360
+    
+    def calculate_named_part(self,
+                             part_name: str,
+                             ascendant: float,
+                             positions: Dict[str, float],
+                             is_day: bool = True) -> float:
+        """
+        Calculate one of the preset Arabic parts.
+        
+        Args:
+            part_name: Name of the part (see ARABIC_PARTS)
+            ascendant: Ascendant position in degrees
+            positions: Dictionary mapping planet names to ecliptic longitudes.
+                      The Descendant is derived from the Ascendant if absent.
+            is_day: Whether the chart is diurnal. Parts listed in
+                   NIGHT_REVERSED_PARTS use the reversed formula by night.
+            
+        Returns:
+            Longitude of the part in degrees (0-360)
+            
+        Raises:
+            ValueError: If the part is unknown or a required position is missing
+        """
+        if part_name not in self.ARABIC_PARTS:
+            raise ValueError(f"Unknown Arabic part: {part_name}")
+        
+        points = {'Descendant': (ascendant + 180) % 360}
+        points.update(positions)
+        
+        added, subtracted = self.ARABIC_PARTS[part_name]
+        if not is_day and part_name in self.NIGHT_REVERSED_PARTS:
+            added, subtracted = subtracted, added
+        
+        missing = [point for point in added + subtracted if point not in points]
+        if missing:
+            raise ValueError(f"Part of {part_name} requires positions for: {', '.join(missing)}")
+        
+        return self.calculate_arabic_part(
+            ascendant,
+            [points[point] for point in added],
+            [points[point] for point in subtracted]
+        )
+    
+    def calculate_moon_age(self, sun_longitude: float, moon_longitude: float) -> float:
+        """
+        Estimate the Moon's age (days since New Moon) from the Sun-Moon elongation.
+        
+        Uses the mean synodic month, so the result can differ from the true
+        time since New Moon by a few hours.
+        
+        Args:
+            sun_longitude: Sun's ecliptic longitude in degrees
+            moon_longitude: Moon's ecliptic longitude in degrees
+            
+        Returns:
+            Age of the Moon in days (0 to ~29.5)
+        """
+        elongation = (moon_longitude - sun_longitude) % 360
+        
+        return elongation / 360 * self.SYNODIC_MONTH
+    
+    def get_lunar_phase(self, sun_longitude: float, moon_longitude: float) -> str:
+        """
+        Get the name of the lunar phase from the Sun-Moon elongation.
+        
+        Args:
+            sun_longitude: Sun's ecliptic longitude in degrees
+            moon_longitude: Moon's ecliptic longitude in degrees
+            
+        Returns:
+            Name of the lunar phase (e.g. 'Waxing Crescent')
+        """
+        elongation = (moon_longitude - sun_longitude) % 360
+        
+        return self.LUNAR_PHASES[int(elongation // 45)]
+    
     def degrees_to_dms(self, degrees: float) -> Tuple[int, int, int]:
         """
         Convert decimal degrees to degrees, minutes, seconds.
@@ -275,10 +1170,23 @@ class ZodiacCalculator:
         Returns:
             Formatted position string
         """
-        zodiac_info = self.ecliptic_to_zodiac(longitude)
-        
         if include_symbol:
-            return zodiac_info['position_string']
+            return self.ecliptic_to_zodiac(longitude)['position_string']
         else:
-            deg, min_val, sec = self.degrees_to_dms(zodiac_info['degree'])
-            return f"{deg}°{min_val:02d}'{sec:02d}\" {zodiac_info['name']}"
\ No newline at end of file
+            sign, deg, min_val, sec = self.get_position_components(longitude)
+            return f"{deg}°{min_val:02d}'{sec:02d}\" {sign}"
+    
+    def get_position_components(self, longitude: float) -> Tuple[str, int, int, int]:
+        """
+        Break a zodiac position into its sign, degrees, minutes and seconds.
+        
+        Args:
+            longitude: Ecliptic longitude in degrees
+            
+        Returns:
+            Tuple of (sign_name, degrees, minutes, seconds) within the sign
+        """
+        zodiac_info = self.ecliptic_to_zodiac(longitude)
+        deg, min_val, sec = self.degrees_to_dms(zodiac_info['degree'])
+        
+        return zodiac_info['name'], deg, min_val, sec
\ No newline at end of file
@@ -13,6 +13,7 @@ setup(
         "numpy>=1.21.0",
         "pandas>=1.3.0",
         "matplotlib>=3.5.0",
+        "Pillow>=8.0.0",
         "astropy>=5.0.0",
         "pytz>=2021.1",
         "swisseph>=2.10.0",
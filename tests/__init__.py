@@ -0,0 +1,3 @@
+"""
+Tests for the qucanft package.
+"""
\ No newline at end of file
@@ -0,0 +1,28 @@
+"""
+Shared helpers for the qucanft tests.
+"""
+
+import math
+
+import pandas as pd
+
+
+# Mean obliquity of the ecliptic at J2000 in degrees
+OBLIQUITY = 23.4392911
+
+
+def make_planetary_data(longitudes):
+    """Build planetary positions from a {planet: longitude} mapping."""
+    return pd.DataFrame([
+        {'Planet': planet, 'Ecliptic_Longitude': longitude}
+        for planet, longitude in longitudes.items()
+    ])
+
+
+def ecliptic_to_equatorial(longitude):
+    """Convert an ecliptic longitude on the ecliptic to (RA, Dec) in degrees."""
+    lon = math.radians(longitude)
+    eps = math.radians(OBLIQUITY)
+    ra = math.degrees(math.atan2(math.sin(lon) * math.cos(eps), math.cos(lon))) % 360
+    dec = math.degrees(math.asin(math.sin(eps) * math.sin(lon)))
+    return ra, dec
\ No newline at end of file
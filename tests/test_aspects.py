@@ -0,0 +1,703 @@
+"""
+Tests for the planetary aspects module.
+"""
+
+import json
+import math
+import unittest
+
+import pandas as pd
+
+from qucanft import AspectsCalculator, ZodiacCalculator
+from qucanft.coordinates import great_circle_separation
+from tests.helpers import make_planetary_data
+
+
+class TestCustomAspects(unittest.TestCase):
+    """Tests for registering aspect types at runtime."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator(include_minor_aspects=False)
+    
+    def test_registered_aspect_is_found(self):
+        self.calculator.register_aspect_type('Centile', 100, 2, symbol='C')
+        
+        aspects = self.calculator.find_aspects_between_planets('Venus', 0, 'Mars', 101)
+        
+        self.assertEqual([aspect['aspect'] for aspect in aspects], ['Centile'])
+        self.assertEqual(aspects[0]['symbol'], 'C')
+        self.assertAlmostEqual(aspects[0]['orb_difference'], 1)
+    
+    def test_unregistered_aspect_is_not_found(self):
+        self.calculator.register_aspect_type('Centile', 100, 2)
+        self.calculator.unregister_aspect_type('Centile')
+        
+        self.assertEqual(self.calculator.find_aspects_between_planets('Venus', 0, 'Mars', 101), [])
+    
+    def test_invalid_aspect_rejected(self):
+        with self.assertRaises(ValueError):
+            self.calculator.register_aspect_type('Wide', 200, 2)
+        with self.assertRaises(ValueError):
+            self.calculator.register_aspect_type('Centile', 100, 0)
+
+
+class TestAngularDistance(unittest.TestCase):
+    """Tests for aspects measured across the Aries point."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator(include_minor_aspects=False)
+    
+    def test_distance_across_aries_point(self):
+        self.assertAlmostEqual(self.calculator.calculate_angular_distance(350, 10), 20)
+        self.assertAlmostEqual(self.calculator.calculate_angular_distance(10, 350), 20)
+    
+    def test_aspects_across_aries_point(self):
+        conjunction = self.calculator.find_aspects_between_planets('Venus', 358, 'Mars', 2)
+        sextile = self.calculator.find_aspects_between_planets('Venus', 350, 'Mars', 50)
+        
+        self.assertEqual([aspect['aspect'] for aspect in conjunction], ['Conjunction'])
+        self.assertAlmostEqual(conjunction[0]['angular_distance'], 4)
+        self.assertEqual([aspect['aspect'] for aspect in sextile], ['Sextile'])
+        self.assertAlmostEqual(sextile[0]['orb_difference'], 0)
+
+
+class TestMoonLatitude(unittest.TestCase):
+    """Tests for measuring lunar aspects along the great circle."""
+    
+    def test_great_circle_separation(self):
+        self.assertAlmostEqual(great_circle_separation(10, 0, 10, 5), 5)
+        self.assertAlmostEqual(great_circle_separation(0, 0, 90, 0), 90)
+        self.assertAlmostEqual(great_circle_separation(100, 5, 100, -5), 10)
+    
+    def test_moon_aspects_use_latitude(self):
+        calculator = AspectsCalculator(include_minor_aspects=False, use_moon_latitude=True)
+        
+        aspects = calculator.find_aspects_between_planets('Moon', 100, 'Mars', 100, 5, -5)
+        
+        self.assertAlmostEqual(aspects[0]['angular_distance'], 10)
+    
+    def test_latitude_ignored_by_default(self):
+        calculator = AspectsCalculator(include_minor_aspects=False)
+        
+        aspects = calculator.find_aspects_between_planets('Moon', 100, 'Mars', 100, 5, -5)
+        
+        self.assertAlmostEqual(aspects[0]['angular_distance'], 0)
+    
+    def test_only_moon_aspects_use_latitude(self):
+        calculator = AspectsCalculator(include_minor_aspects=False, use_moon_latitude=True)
+        planetary_data = pd.DataFrame([
+            {'Planet': 'Moon', 'Ecliptic_Longitude': 100, 'Ecliptic_Latitude': 5},
+            {'Planet': 'Venus', 'Ecliptic_Longitude': 100, 'Ecliptic_Latitude': 3},
+            {'Planet': 'Mars', 'Ecliptic_Longitude': 100, 'Ecliptic_Latitude': -5}
+        ])
+        
+        aspects = calculator.calculate_all_aspects(planetary_data)
+        distances = {(row['planet1'], row['planet2']): row['angular_distance']
+                     for _, row in aspects.iterrows()}
+        
+        self.assertAlmostEqual(distances[('Moon', 'Venus')], 2)
+        self.assertAlmostEqual(distances[('Moon', 'Mars')], 10)
+        self.assertAlmostEqual(distances[('Venus', 'Mars')], 0)
+
+
+class TestMoietyOrbs(unittest.TestCase):
+    """Tests for orbs from summed planetary moieties."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator(orb_mode='moiety')
+    
+    def test_major_aspects_sum_moieties(self):
+        self.assertAlmostEqual(self.calculator.calculate_orb('Sun', 'Moon', 8), 13.5)
+        self.assertAlmostEqual(self.calculator.calculate_orb('Mercury', 'Venus', 8, 'Conjunction'), 7)
+    
+    def test_minor_aspects_keep_scaled_orb(self):
+        self.assertAlmostEqual(self.calculator.calculate_orb('Sun', 'Moon', 3, 'Semisquare'), 4.5)
+        
+        aspects = self.calculator.find_aspects_between_planets('Sun', 0, 'Moon', 42)
+        
+        self.assertEqual([aspect['aspect'] for aspect in aspects], ['Semisquare'])
+    
+    def test_moiety_tighter_than_aspect_orb(self):
+        aspect_mode = AspectsCalculator(include_minor_aspects=False)
+        moiety_mode = AspectsCalculator(include_minor_aspects=False, orb_mode='moiety')
+        
+        self.assertAlmostEqual(aspect_mode.calculate_orb('Sun', 'Saturn', 8), 12)
+        self.assertEqual(len(aspect_mode.find_aspects_between_planets('Mercury', 0, 'Venus', 7.5)), 1)
+        self.assertEqual(moiety_mode.find_aspects_between_planets('Mercury', 0, 'Venus', 7.5), [])
+    
+    def test_unknown_orb_mode_rejected(self):
+        with self.assertRaises(ValueError):
+            AspectsCalculator(orb_mode='partile')
+
+
+class TestDegreeClusters(unittest.TestCase):
+    """Tests for clusters of planets found by degree rather than sign."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator()
+    
+    def test_cluster_across_aries_point(self):
+        planetary_data = make_planetary_data({'Sun': 355, 'Mercury': 358, 'Venus': 3, 'Mars': 100})
+        
+        clusters = self.calculator.find_degree_clusters(planetary_data)
+        
+        self.assertEqual(len(clusters), 1)
+        self.assertEqual(clusters[0]['planets'], ['Sun', 'Mercury', 'Venus'])
+        self.assertIn('8.0°', clusters[0]['description'])
+    
+    def test_spread_limits_cluster(self):
+        planetary_data = make_planetary_data({'Sun': 0, 'Mercury': 6, 'Venus': 12})
+        
+        self.assertEqual(self.calculator.find_degree_clusters(planetary_data), [])
+        self.assertEqual(len(self.calculator.find_degree_clusters(planetary_data, max_spread=12)), 1)
+    
+    def test_separate_clusters(self):
+        planetary_data = make_planetary_data({
+            'Sun': 10, 'Mercury': 14, 'Venus': 17,
+            'Mars': 200, 'Jupiter': 203, 'Saturn': 209
+        })
+        
+        clusters = self.calculator.find_degree_clusters(planetary_data)
+        
+        self.assertEqual([cluster['planets'] for cluster in clusters],
+                         [['Mars', 'Jupiter', 'Saturn'], ['Sun', 'Mercury', 'Venus']])
+
+
+class TestAspectsJson(unittest.TestCase):
+    """Tests for exporting aspects as JSON."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator(include_minor_aspects=False)
+    
+    def test_aspects_exported_with_symbols_and_meanings(self):
+        aspects = self.calculator.calculate_all_aspects(make_planetary_data({'Sun': 0, 'Moon': 122}))
+        
+        records = json.loads(self.calculator.export_aspects_json(aspects))
+        
+        self.assertEqual(len(records), 1)
+        self.assertEqual(records[0]['aspect'], 'Trine')
+        self.assertEqual(records[0]['symbol'], '△')
+        self.assertAlmostEqual(records[0]['orb'], 2)
+        self.assertEqual(records[0]['interpretation'],
+                         self.calculator.get_aspect_interpretation('Trine', 'Sun', 'Moon'))
+    
+    def test_no_aspects_export_empty_list(self):
+        aspects = self.calculator.calculate_all_aspects(make_planetary_data({'Venus': 0, 'Mars': 100}))
+        
+        self.assertEqual(json.loads(self.calculator.export_aspects_json(aspects)), [])
+
+
+class TestAspectsToRuler(unittest.TestCase):
+    """Tests for highlighting aspects to the chart ruler."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator(include_minor_aspects=False)
+        self.aspects = self.calculator.calculate_all_aspects(
+            make_planetary_data({'Sun': 0, 'Mars': 90, 'Venus': 180})
+        )
+    
+    def aspect_pairs(self, aspects):
+        return sorted((row['planet1'], row['planet2'], row['aspect']) for _, row in aspects.iterrows())
+    
+    def test_aries_rising_highlights_mars(self):
+        ruler_aspects = self.calculator.get_aspects_to_ruler(self.aspects, 10)
+        
+        self.assertEqual(self.aspect_pairs(ruler_aspects),
+                         [('Mars', 'Venus', 'Square'), ('Sun', 'Mars', 'Square')])
+    
+    def test_taurus_rising_highlights_venus(self):
+        ruler_aspects = self.calculator.get_aspects_to_ruler(self.aspects, 40)
+        
+        self.assertEqual(self.aspect_pairs(ruler_aspects),
+                         [('Mars', 'Venus', 'Square'), ('Sun', 'Venus', 'Opposition')])
+
+
+class TestChartCompatibility(unittest.TestCase):
+    """Tests for scoring compatibility from synastry aspects."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator(include_minor_aspects=False)
+    
+    def score(self, chart1, chart2):
+        return self.calculator.calculate_chart_compatibility(make_planetary_data(chart1),
+                                                             make_planetary_data(chart2))
+    
+    def test_harmonious_and_challenging_aspects(self):
+        self.assertAlmostEqual(self.score({'Sun': 0}, {'Moon': 120}), 100)
+        self.assertAlmostEqual(self.score({'Sun': 0}, {'Moon': 90}), 0)
+        self.assertAlmostEqual(self.score({'Sun': 0}, {'Moon': 0}), 75)
+    
+    def test_aspects_weighted_by_exactness(self):
+        # An exact trine outweighs a square 6° from exact
+        score = self.score({'Sun': 0, 'Venus': 200}, {'Moon': 120, 'Mars': 284})
+        
+        self.assertGreater(score, 50)
+        self.assertLess(score, 100)
+    
+    def test_no_aspects_is_neutral(self):
+        self.assertEqual(self.score({'Sun': 0}, {'Moon': 105}), 50.0)
+    
+    def test_outer_planets_ignored(self):
+        self.assertEqual(self.score({'Sun': 0}, {'Saturn': 90}), 50.0)
+
+
+class TestSortAspects(unittest.TestCase):
+    """Tests for sorting aspects by different keys."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator()
+        self.aspects = pd.DataFrame([
+            {'planet1': 'Venus', 'planet2': 'Mars', 'aspect': 'Square', 'degrees': 90,
+             'orb_difference': 1.0, 'exactness': 80.0},
+            {'planet1': 'Sun', 'planet2': 'Moon', 'aspect': 'Trine', 'degrees': 120,
+             'orb_difference': 3.0, 'exactness': 60.0},
+            {'planet1': 'Mercury', 'planet2': 'Jupiter', 'aspect': 'Sextile', 'degrees': 60,
+             'orb_difference': 0.5, 'exactness': 70.0}
+        ])
+    
+    def sorted_names(self, by):
+        return list(self.calculator.sort_aspects(self.aspects, by)['aspect'])
+    
+    def test_sort_keys(self):
+        self.assertEqual(self.sorted_names('strength'), ['Square', 'Sextile', 'Trine'])
+        self.assertEqual(self.sorted_names('orb'), ['Sextile', 'Square', 'Trine'])
+        self.assertEqual(self.sorted_names('angle'), ['Sextile', 'Square', 'Trine'])
+        self.assertEqual(self.sorted_names('planet'), ['Sextile', 'Trine', 'Square'])
+    
+    def test_unknown_sort_key_rejected(self):
+        with self.assertRaises(ValueError):
+            self.calculator.sort_aspects(self.aspects, 'nature')
+
+
+class TestClosestAspect(unittest.TestCase):
+    """Tests for the tightest aspect between two planets."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator()
+        self.aspects = self.calculator.calculate_all_aspects(
+            make_planetary_data({'Sun': 0, 'Moon': 41.2, 'Mars': 200})
+        )
+    
+    def test_tightest_of_several_aspects(self):
+        pair = self.calculator.get_planet_aspects(self.aspects, 'Moon')
+        self.assertEqual(sorted(pair['aspect']), ['Novile', 'Semisquare'])
+        
+        closest = self.calculator.get_closest_aspect(self.aspects, 'Sun', 'Moon')
+        
+        self.assertEqual(closest['aspect'], 'Novile')
+    
+    def test_planet_order_does_not_matter(self):
+        closest = self.calculator.get_closest_aspect(self.aspects, 'Moon', 'Sun')
+        
+        self.assertEqual(closest['aspect'], 'Novile')
+    
+    def test_no_aspect_between_planets(self):
+        self.assertIsNone(self.calculator.get_closest_aspect(self.aspects, 'Moon', 'Mars'))
+        self.assertIsNone(self.calculator.get_closest_aspect(pd.DataFrame(), 'Sun', 'Moon'))
+
+
+class TestDissociateAspects(unittest.TestCase):
+    """Tests for flagging and weakening out-of-sign aspects."""
+    
+    def test_sign_distance(self):
+        calculator = AspectsCalculator()
+        
+        # Sextile from Aries to Cancer, and from Aries to Gemini
+        self.assertTrue(calculator.is_dissociate(28, 92, 60))
+        self.assertFalse(calculator.is_dissociate(25, 85, 60))
+        # Aspects that aren't multiples of 30° have no sign counterpart
+        self.assertFalse(calculator.is_dissociate(28, 73, 45))
+    
+    def test_dissociate_aspect_weakened(self):
+        planetary_data = make_planetary_data({'Venus': 28, 'Mars': 152})
+        
+        plain = AspectsCalculator(include_minor_aspects=False).calculate_all_aspects(planetary_data)
+        flagged = AspectsCalculator(include_minor_aspects=False,
+                                    detect_dissociate=True).calculate_all_aspects(planetary_data)
+        
+        self.assertNotIn('dissociate', plain.columns)
+        self.assertTrue(flagged.iloc[0]['dissociate'])
+        self.assertAlmostEqual(flagged.iloc[0]['exactness'], plain.iloc[0]['exactness'] / 2)
+    
+    def test_in_sign_aspect_kept(self):
+        aspects = AspectsCalculator(include_minor_aspects=False,
+                                    detect_dissociate=True).calculate_all_aspects(
+            make_planetary_data({'Venus': 28, 'Mars': 145}))
+        
+        self.assertFalse(aspects.iloc[0]['dissociate'])
+
+
+class TestDissociateWithOffset(unittest.TestCase):
+    """Tests for sign-based aspect checks under a zodiac reference offset."""
+    
+    def test_dissociate_uses_offset_signs(self):
+        sidereal = ZodiacCalculator()
+        sidereal.set_reference_offset(24)
+        
+        # A trine from 25° to 142° links Aries and Leo tropically, but Aries
+        # and Cancer once the signs are shifted by 24°
+        self.assertFalse(AspectsCalculator().is_dissociate(25, 142, 120))
+        self.assertTrue(AspectsCalculator(zodiac_calculator=sidereal).is_dissociate(25, 142, 120))
+
+
+class TestCradlePattern(unittest.TestCase):
+    """Tests for Cradle detection in aspect patterns."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator(include_minor_aspects=False)
+    
+    def test_cradle_found(self):
+        aspects = self.calculator.calculate_all_aspects(
+            make_planetary_data({'Venus': 0, 'Mars': 60, 'Jupiter': 120, 'Saturn': 180}))
+        
+        cradles = [pattern for pattern in self.calculator.calculate_aspect_patterns(aspects)
+                   if pattern['pattern'] == 'Cradle']
+        
+        self.assertEqual(len(cradles), 1)
+        self.assertEqual(cradles[0]['planets'], ['Venus', 'Mars', 'Jupiter', 'Saturn'])
+    
+    def test_no_cradle_without_opposition(self):
+        aspects = self.calculator.calculate_all_aspects(
+            make_planetary_data({'Venus': 0, 'Mars': 60, 'Jupiter': 120, 'Saturn': 160}))
+        
+        patterns = self.calculator.calculate_aspect_patterns(aspects)
+        
+        self.assertNotIn('Cradle', [pattern['pattern'] for pattern in patterns])
+
+
+class TestLunationTagging(unittest.TestCase):
+    """Tests for describing the Sun-Moon aspect with the lunar phase."""
+    
+    def test_phase_in_description(self):
+        calculator = AspectsCalculator(include_minor_aspects=False, tag_lunation=True)
+        
+        aspects = calculator.calculate_all_aspects(make_planetary_data({'Moon': 270, 'Sun': 0, 'Mars': 120}))
+        
+        lunation = aspects[aspects['aspect'] == 'Square'].iloc[0]
+        self.assertEqual(lunation['description'], 'Moon Square Sun (Last Quarter)')
+        # Only the Sun-Moon aspect is described
+        self.assertTrue(pd.isna(aspects[aspects['aspect'] == 'Trine'].iloc[0]['description']))
+    
+    def test_untagged_by_default(self):
+        aspects = AspectsCalculator(include_minor_aspects=False).calculate_all_aspects(
+            make_planetary_data({'Sun': 0, 'Moon': 90}))
+        
+        self.assertNotIn('description', aspects.columns)
+
+
+class TestSynastryGrid(unittest.TestCase):
+    """Tests for the planet-by-planet synastry grid."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator(include_minor_aspects=False)
+    
+    def test_grid_holds_closest_aspect(self):
+        grid = self.calculator.calculate_synastry_grid(
+            make_planetary_data({'Sun': 0, 'Moon': 150}),
+            make_planetary_data({'Venus': 122, 'Mars': 181})
+        )
+        
+        self.assertEqual(list(grid.index), ['Sun', 'Moon'])
+        self.assertEqual(list(grid.columns), ['Venus', 'Mars'])
+        self.assertEqual(grid.loc['Sun', 'Venus'], 'Trine')
+        self.assertEqual(grid.loc['Sun', 'Mars'], 'Opposition')
+        self.assertIsNone(grid.loc['Moon', 'Venus'])
+        self.assertIsNone(grid.loc['Moon', 'Mars'])
+    
+    def test_requires_longitudes(self):
+        with self.assertRaises(ValueError):
+            self.calculator.calculate_synastry_grid(make_planetary_data({'Sun': 0}),
+                                                    pd.DataFrame({'Planet': ['Venus']}))
+
+
+class TestAnglesAndTSquares(unittest.TestCase):
+    """Tests for aspects to chart angles and T-Square apexes."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator(include_minor_aspects=False)
+    
+    def test_aspects_to_angles(self):
+        aspects = self.calculator.calculate_all_aspects(make_planetary_data({'Mars': 92}),
+                                                        angles={'Ascendant': 90, 'Midheaven': 0})
+        
+        pairs = {(row['planet1'], row['planet2']): row['aspect'] for _, row in aspects.iterrows()}
+        self.assertEqual(pairs, {('Mars', 'Ascendant'): 'Conjunction', ('Mars', 'Midheaven'): 'Square'})
+    
+    def test_t_square_apex(self):
+        aspects = self.calculator.calculate_all_aspects(
+            make_planetary_data({'Mars': 0, 'Saturn': 182, 'Venus': 90, 'Jupiter': 30}))
+        
+        t_squares = [pattern for pattern in self.calculator.calculate_aspect_patterns(aspects)
+                     if pattern['pattern'] == 'T-Square']
+        
+        self.assertEqual(len(t_squares), 1)
+        self.assertEqual(t_squares[0]['apex'], 'Venus')
+        self.assertEqual(set(t_squares[0]['planets']), {'Mars', 'Saturn', 'Venus'})
+    
+    def test_t_square_with_ascendant_apex(self):
+        aspects = self.calculator.calculate_all_aspects(make_planetary_data({'Mars': 2, 'Saturn': 178}),
+                                                        angles={'Ascendant': 90, 'Midheaven': 45})
+        
+        t_squares = [pattern for pattern in self.calculator.calculate_aspect_patterns(aspects)
+                     if pattern['pattern'] == 'T-Square']
+        
+        self.assertEqual(len(t_squares), 1)
+        self.assertEqual(t_squares[0]['apex'], 'Ascendant')
+        self.assertEqual(set(t_squares[0]['planets']), {'Mars', 'Saturn', 'Ascendant'})
+    
+    def test_t_square_apexes_in_stable_order(self):
+        # Both ends of the Mars-Saturn opposition are squared by Venus and Pluto
+        aspects = self.calculator.calculate_all_aspects(
+            make_planetary_data({'Mars': 0, 'Saturn': 180, 'Venus': 90, 'Pluto': 270}))
+        
+        apexes = [pattern['apex'] for pattern in self.calculator.calculate_aspect_patterns(aspects)
+                  if pattern['pattern'] == 'T-Square' and set(pattern['planets'][:2]) == {'Mars', 'Saturn'}]
+        
+        self.assertEqual(apexes, ['Pluto', 'Venus'])
+    
+    def test_no_t_square_without_apex(self):
+        aspects = self.calculator.calculate_all_aspects(make_planetary_data({'Mars': 0, 'Saturn': 180, 'Venus': 60}))
+        
+        patterns = self.calculator.calculate_aspect_patterns(aspects)
+        
+        self.assertNotIn('T-Square', [pattern['pattern'] for pattern in patterns])
+
+
+class TestDeclinationGrid(unittest.TestCase):
+    """Tests for parallels and contraparallels of declination."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator()
+        self.planetary_data = pd.DataFrame({
+            'Planet': ['Sun', 'Moon', 'Mars', 'Venus'],
+            'Dec': [20.0, 20.5, -19.8, 5.0]
+        })
+    
+    def test_parallels_and_contraparallels(self):
+        grid = self.calculator.calculate_declination_grid(self.planetary_data)
+        
+        self.assertEqual(grid.loc['Sun', 'Moon'], 'P')
+        self.assertEqual(grid.loc['Moon', 'Sun'], 'P')
+        self.assertEqual(grid.loc['Sun', 'Mars'], 'CP')
+        self.assertEqual(grid.loc['Moon', 'Mars'], 'CP')
+        self.assertEqual(grid.loc['Venus', 'Sun'], '')
+        self.assertEqual(grid.loc['Sun', 'Sun'], '')
+    
+    def test_custom_orb(self):
+        grid = self.calculator.calculate_declination_grid(self.planetary_data, orb=0.4)
+        
+        self.assertEqual(grid.loc['Sun', 'Moon'], '')
+        self.assertEqual(grid.loc['Sun', 'Mars'], 'CP')
+    
+    def test_requires_declinations(self):
+        with self.assertRaises(ValueError):
+            self.calculator.calculate_declination_grid(make_planetary_data({'Sun': 0}))
+
+
+class TestMutualApplication(unittest.TestCase):
+    """Tests for aspects that both planets move to perfect."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator()
+    
+    def test_both_planets_closing(self):
+        # An 85° gap widening to a square from both ends
+        self.assertTrue(self.calculator.is_mutually_applying(0, -1.0, 85, 0.5, 90))
+        self.assertTrue(self.calculator.is_mutually_applying(350, -1.0, 75, 0.5, 90))
+        self.assertTrue(self.calculator.is_mutually_applying(85, 0.5, 0, -1.0, 90))
+    
+    def test_one_planet_catching_up(self):
+        # Mars widens the gap but Venus, moving the same way, narrows it
+        self.assertFalse(self.calculator.is_mutually_applying(0, 1.2, 85, 1.0, 90))
+    
+    def test_separating_and_exact(self):
+        self.assertFalse(self.calculator.is_mutually_applying(0, 1.0, 85, -0.5, 90))
+        self.assertFalse(self.calculator.is_mutually_applying(0, -1.0, 90, 0.5, 90))
+    
+    def test_faster_planet_catching_up_applies(self):
+        # Closing from 95° to a square, but moving away from it at 85°
+        self.assertTrue(self.calculator.is_applying(0, 1.2, 95, 1.0, 90))
+        self.assertFalse(self.calculator.is_applying(0, 1.2, 85, 1.0, 90))
+        self.assertFalse(self.calculator.is_applying(0, 1.0, 90, 0.5, 90))
+
+
+class TestTranslationOfLight(unittest.TestCase):
+    """Tests for a fast planet carrying light between two others."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator()
+        self.speeds = {'Moon': 13.0, 'Venus': 1.2, 'Mars': 0.6}
+    
+    def test_moon_translates_from_venus_to_mars(self):
+        # The Moon has just passed Venus and is closing on a square to Mars
+        planetary_data = make_planetary_data({'Venus': 10, 'Moon': 15, 'Mars': 110})
+        
+        self.assertEqual(self.calculator.find_translations_of_light(planetary_data, self.speeds),
+                         [('Moon', 'Venus', 'Mars')])
+    
+    def test_no_translation_while_applying_to_both(self):
+        planetary_data = make_planetary_data({'Venus': 10, 'Moon': 5, 'Mars': 110})
+        
+        self.assertEqual(self.calculator.find_translations_of_light(planetary_data, self.speeds), [])
+    
+    def test_slower_planet_cannot_translate(self):
+        planetary_data = make_planetary_data({'Venus': 10, 'Moon': 15, 'Mars': 110})
+        speeds = {'Moon': 0.5, 'Venus': 1.2, 'Mars': 0.6}
+        
+        self.assertEqual(self.calculator.find_translations_of_light(planetary_data, speeds), [])
+
+
+class TestAspectDensity(unittest.TestCase):
+    """Tests for the share of planet pairs that are aspected."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator()
+        self.aspects = pd.DataFrame([
+            {'planet1': 'Sun', 'planet2': 'Moon', 'aspect': 'Trine', 'exactness': 80.0},
+            {'planet1': 'Moon', 'planet2': 'Sun', 'aspect': 'Quintile', 'exactness': 40.0},
+            {'planet1': 'Sun', 'planet2': 'Mars', 'aspect': 'Square', 'exactness': 50.0},
+            {'planet1': 'Moon', 'planet2': 'Venus', 'aspect': 'Sextile', 'exactness': 100.0}
+        ])
+    
+    def test_pairs_counted_once(self):
+        # Three of the six pairs among four planets are aspected
+        self.assertAlmostEqual(self.calculator.calculate_aspect_density(self.aspects, 4), 0.5)
+    
+    def test_weighted_by_tightest_aspect(self):
+        self.assertAlmostEqual(self.calculator.calculate_aspect_density(self.aspects, 4, weighted=True),
+                               (0.8 + 0.5 + 1.0) / 6)
+    
+    def test_no_pairs(self):
+        self.assertEqual(self.calculator.calculate_aspect_density(pd.DataFrame(), 4), 0.0)
+        self.assertEqual(self.calculator.calculate_aspect_density(self.aspects, 1), 0.0)
+
+
+class TestStrengthModels(unittest.TestCase):
+    """Tests for the curves mapping orb to exactness."""
+    
+    def test_linear(self):
+        calculator = AspectsCalculator()
+        
+        self.assertAlmostEqual(calculator.calculate_exactness(0, 8), 100)
+        self.assertAlmostEqual(calculator.calculate_exactness(2, 8), 75)
+        self.assertAlmostEqual(calculator.calculate_exactness(8, 8), 0)
+    
+    def test_gaussian(self):
+        calculator = AspectsCalculator(strength_model='gaussian')
+        
+        self.assertAlmostEqual(calculator.calculate_exactness(0, 8), 100)
+        # exp(-(1/4)^2 / (2 * (1/3)^2)) and exp(-(1/2)^2 / (2 * (1/3)^2))
+        self.assertAlmostEqual(calculator.calculate_exactness(2, 8), 100 * math.exp(-0.28125))
+        self.assertAlmostEqual(calculator.calculate_exactness(4, 8), 100 * math.exp(-1.125))
+        # The curve has not quite reached zero at the edge of the orb
+        self.assertAlmostEqual(calculator.calculate_exactness(8, 8), 1.11, places=2)
+    
+    def test_cosine(self):
+        calculator = AspectsCalculator(strength_model='cosine')
+        
+        self.assertAlmostEqual(calculator.calculate_exactness(0, 8), 100)
+        self.assertAlmostEqual(calculator.calculate_exactness(2, 8), 85.355, places=3)
+        self.assertAlmostEqual(calculator.calculate_exactness(4, 8), 50)
+        self.assertAlmostEqual(calculator.calculate_exactness(8, 8), 0)
+    
+    def test_unknown_model_rejected(self):
+        with self.assertRaises(ValueError):
+            AspectsCalculator(strength_model='quadratic')
+
+
+class TestIncompleteTrines(unittest.TestCase):
+    """Tests for finding the empty vertex of a Grand Trine."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator(include_minor_aspects=False)
+    
+    def incomplete_trines(self, longitudes):
+        planetary_data = make_planetary_data(longitudes)
+        aspects = self.calculator.calculate_all_aspects(planetary_data)
+        return self.calculator.find_incomplete_trines(aspects, planetary_data)
+    
+    def test_completing_degree(self):
+        incomplete = self.incomplete_trines({'Venus': 0, 'Mars': 122})
+        
+        self.assertEqual(len(incomplete), 1)
+        self.assertEqual(incomplete[0]['planets'], ['Venus', 'Mars'])
+        self.assertAlmostEqual(incomplete[0]['completing_degree'], 241)
+    
+    def test_trine_across_aries_point(self):
+        incomplete = self.incomplete_trines({'Venus': 350, 'Mars': 110})
+        
+        self.assertAlmostEqual(incomplete[0]['completing_degree'], 230)
+    
+    def test_occupied_vertex(self):
+        self.assertEqual(self.incomplete_trines({'Venus': 0, 'Mars': 120, 'Jupiter': 245}), [])
+    
+    def test_trines_to_angles(self):
+        planetary_data = make_planetary_data({'Venus': 0})
+        angles = {'Ascendant': 120, 'Midheaven': 30}
+        aspects = self.calculator.calculate_all_aspects(planetary_data, angles=angles)
+        
+        self.assertEqual(self.calculator.find_incomplete_trines(aspects, planetary_data), [])
+        
+        incomplete = self.calculator.find_incomplete_trines(aspects, planetary_data, angles=angles)
+        self.assertEqual(len(incomplete), 1)
+        self.assertEqual(incomplete[0]['planets'], ['Venus', 'Ascendant'])
+        self.assertAlmostEqual(incomplete[0]['completing_degree'], 240)
+
+
+class TestAspectValence(unittest.TestCase):
+    """Tests for netting harmonious against challenging aspects."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator()
+    
+    def test_net_valence(self):
+        aspects = pd.DataFrame([
+            {'aspect': 'Trine', 'nature': 'Harmonious', 'exactness': 90.0},
+            {'aspect': 'Square', 'nature': 'Challenging', 'exactness': 50.0},
+            {'aspect': 'Conjunction', 'nature': 'Neutral', 'exactness': 100.0},
+            {'aspect': 'Semisquare', 'nature': 'Challenging', 'exactness': 60.0},
+            {'aspect': 'Quintile', 'nature': 'Creative', 'exactness': 100.0}
+        ])
+        
+        # 0.9 - 0.5 - 0.6 * 0.5, with neutral and creative aspects not counted
+        self.assertAlmostEqual(self.calculator.calculate_aspect_valence(aspects), 0.1)
+    
+    def test_challenging_chart(self):
+        aspects = pd.DataFrame([
+            {'aspect': 'Opposition', 'nature': 'Challenging', 'exactness': 90.0},
+            {'aspect': 'Sextile', 'nature': 'Harmonious', 'exactness': 40.0}
+        ])
+        
+        self.assertAlmostEqual(self.calculator.calculate_aspect_valence(aspects), -0.5)
+    
+    def test_no_aspects(self):
+        self.assertEqual(self.calculator.calculate_aspect_valence(pd.DataFrame()), 0.0)
+
+
+class TestDialContacts(unittest.TestCase):
+    """Tests for cosmobiology dial positions and contacts."""
+    
+    def setUp(self):
+        self.calculator = AspectsCalculator()
+    
+    def test_dial_position(self):
+        self.assertAlmostEqual(self.calculator.calculate_dial_position(100), 10)
+        self.assertAlmostEqual(self.calculator.calculate_dial_position(100, dial=45), 10)
+        self.assertAlmostEqual(self.calculator.calculate_dial_position(100, dial=360), 100)
+    
+    def test_hard_aspects_coincide(self):
+        planetary_data = make_planetary_data({'Sun': 10, 'Moon': 100, 'Mars': 190.5, 'Venus': 50})
+        
+        self.assertEqual(self.calculator.find_dial_contacts(planetary_data),
+                         [('Sun', 'Moon'), ('Sun', 'Mars'), ('Moon', 'Mars')])
+        self.assertEqual(self.calculator.find_dial_contacts(planetary_data, dial=360), [])
+    
+    def test_contact_across_dial_zero(self):
+        planetary_data = make_planetary_data({'Sun': 0.5, 'Jupiter': 269.8})
+        
+        self.assertEqual(self.calculator.find_dial_contacts(planetary_data), [('Sun', 'Jupiter')])
+        self.assertEqual(self.calculator.find_dial_contacts(planetary_data, orb=0.5), [])
+
+
+if __name__ == '__main__':
+    unittest.main()
\ No newline at end of file
@@ -0,0 +1,399 @@
+"""
+Tests for the astronomical data fetching module.
+
+JPL Horizons is never queried; ephemerides are generated from simple
+linear motion models instead.
+"""
+
+import math
+import unittest
+from datetime import datetime, timedelta, timezone
+from unittest import mock
+
+import pandas as pd
+from astropy.time import Time
+
+from qucanft import AstroDataFetcher
+from tests.helpers import ecliptic_to_equatorial
+
+
+# Start of the synthetic ephemerides used throughout
+JD0 = Time('2020-12-01T00:00:00').jd
+
+# Length of each Horizons step unit in days
+STEP_UNITS = {'d': 1.0, 'h': 1 / 24, 'm': 1 / 1440}
+
+
+def step_to_days(step):
+    """Convert a Horizons step such as '1d' or '30m' to days."""
+    return float(step[:-1]) * STEP_UNITS[step[-1]]
+
+
+def linear_motion(start_longitude, daily_motion):
+    """Build an ecliptic longitude model that moves steadily from JD0."""
+    return lambda julian_day: (start_longitude + daily_motion * (julian_day - JD0)) % 360
+
+
+def solar_longitude(julian_day):
+    """Apparent solar longitude to about 0.01° (Meeus, Astronomical Algorithms, ch. 25)."""
+    t = (julian_day - 2451545.0) / 36525
+    mean_anomaly = math.radians(357.52911 + 35999.05029 * t)
+    center = (1.914602 - 0.004817 * t) * math.sin(mean_anomaly) \
+        + 0.019993 * math.sin(2 * mean_anomaly) + 0.000289 * math.sin(3 * mean_anomaly)
+    node = math.radians(125.04 - 1934.136 * t)
+    return (280.46646 + 36000.76983 * t + center - 0.00569 - 0.00478 * math.sin(node)) % 360
+
+
+def sample_times(start_time, end_time, step):
+    """Julian days from start_time to end_time inclusive at the given step."""
+    interval = step_to_days(step)
+    count = int(round((end_time.jd - start_time.jd) / interval))
+    return [start_time.jd + i * interval for i in range(count + 1)]
+
+
+class FakeEphemeris:
+    """Stand-in for the astropy Table returned by Horizons.ephemerides."""
+    
+    def __init__(self, rows):
+        self.rows = rows
+        self.colnames = list(rows[0]) if rows else []
+    
+    def __getitem__(self, column):
+        return [row[column] for row in self.rows]
+    
+    def to_pandas(self):
+        return pd.DataFrame(self.rows)
+
+
+def fake_horizons(models):
+    """Build a Horizons replacement serving ephemerides from longitude models."""
+    names = {planet_id: planet for planet, planet_id in AstroDataFetcher.PLANETS.items()}
+    
+    class FakeHorizons:
+        def __init__(self, id, location, epochs):
+            model = models[names[id]]
+            if isinstance(epochs, dict):
+                times = sample_times(Time(epochs['start']), Time(epochs['stop']), epochs['step'])
+            else:
+                times = [epochs]
+            
+            self.rows = []
+            for julian_day in times:
+                ra, dec = ecliptic_to_equatorial(model(julian_day))
+                self.rows.append({
+                    'datetime_str': Time(julian_day, format='jd').iso,
+                    'datetime_jd': julian_day,
+                    'RA': ra,
+                    'DEC': dec,
+                    'delta': 1.0
+                })
+        
+        def ephemerides(self, quantities=None):
+            return FakeEphemeris(self.rows)
+    
+    return FakeHorizons
+
+
+def patch_longitudes(fetcher, models):
+    """Patch the fetcher so each planet's longitude samples follow its model."""
+    def samples(planet, start_time, end_time, step, location=None, apparent=False):
+        return [(julian_day, models[planet](julian_day))
+                for julian_day in sample_times(start_time, end_time, step)]
+    
+    return mock.patch.object(fetcher, '_get_longitude_samples', side_effect=samples)
+
+
+class TestFindConjunction(unittest.TestCase):
+    """Tests for timing the conjunction of two moving planets."""
+    
+    def setUp(self):
+        self.fetcher = AstroDataFetcher()
+    
+    def test_conjunction_time_and_longitude(self):
+        models = {'Jupiter': linear_motion(300.0, 0.083), 'Saturn': linear_motion(300.5, 0.033)}
+        
+        with patch_longitudes(self.fetcher, models):
+            conjunction = self.fetcher.find_conjunction('Jupiter', 'Saturn',
+                                                        Time(JD0, format='jd'),
+                                                        Time(JD0 + 30, format='jd'))
+        
+        self.assertAlmostEqual(conjunction['jd'], JD0 + 10, places=5)
+        self.assertAlmostEqual(conjunction['longitude'], 300.83, places=4)
+        self.assertEqual(conjunction['datetime'], Time(conjunction['jd'], format='jd').iso)
+    
+    def test_conjunction_across_aries_point(self):
+        models = {'Jupiter': linear_motion(359.5, 0.083), 'Saturn': linear_motion(0.0, 0.033)}
+        
+        with patch_longitudes(self.fetcher, models):
+            conjunction = self.fetcher.find_conjunction('Jupiter', 'Saturn',
+                                                        Time(JD0, format='jd'),
+                                                        Time(JD0 + 30, format='jd'))
+        
+        self.assertAlmostEqual(conjunction['jd'], JD0 + 10, places=5)
+        self.assertAlmostEqual(conjunction['longitude'], 0.33, places=4)
+    
+    def test_opposition_is_not_a_conjunction(self):
+        models = {'Jupiter': linear_motion(120.0, 0.083), 'Saturn': linear_motion(300.5, 0.033)}
+        
+        with patch_longitudes(self.fetcher, models):
+            with self.assertRaises(ValueError):
+                self.fetcher.find_conjunction('Jupiter', 'Saturn',
+                                              Time(JD0, format='jd'),
+                                              Time(JD0 + 30, format='jd'))
+
+
+class TestRetrogradePlanets(unittest.TestCase):
+    """Tests for listing the planets moving retrograde."""
+    
+    def setUp(self):
+        self.fetcher = AstroDataFetcher()
+    
+    def test_planets_moving_backwards(self):
+        models = {
+            'Mercury': linear_motion(45.0, -1.0),
+            'Venus': linear_motion(10.0, 1.2),
+            'Mars': linear_motion(0.01, -0.5),
+            'Jupiter': linear_motion(359.99, 0.5)
+        }
+        
+        with mock.patch('qucanft.astro_data.Horizons', fake_horizons(models)):
+            retrograde = self.fetcher.get_retrograde_planets(Time(JD0, format='jd'),
+                                                             planets=list(models))
+        
+        self.assertEqual(retrograde, ['Mercury', 'Mars'])
+    
+    def test_daily_motion(self):
+        models = {'Moon': linear_motion(359.9, 13.2), 'Mercury': linear_motion(45.0, -1.0)}
+        
+        with mock.patch('qucanft.astro_data.Horizons', fake_horizons(models)):
+            speeds = self.fetcher.get_planet_speeds(Time(JD0, format='jd'), planets=list(models))
+        
+        self.assertEqual(list(speeds), ['Moon', 'Mercury'])
+        self.assertAlmostEqual(speeds['Moon'], 13.2, places=6)
+        self.assertAlmostEqual(speeds['Mercury'], -1.0, places=6)
+    
+    def test_single_sample_rejected(self):
+        single_sample = pd.DataFrame([{'datetime_jd': JD0, 'RA': 10.0, 'DEC': 4.0}])
+        
+        with mock.patch.object(self.fetcher, 'get_ephemeris_range', return_value=single_sample):
+            with self.assertRaises(RuntimeError):
+                self.fetcher.get_retrograde_planets(Time(JD0, format='jd'), planets=['Mars'])
+
+
+class TestSolarLongitudes(unittest.TestCase):
+    """Tests for the Sun's geometric and apparent longitude of date."""
+    
+    def setUp(self):
+        self.fetcher = AstroDataFetcher()
+        # Meeus, Astronomical Algorithms, example 25.b: 1992 October 13, 0h TD.
+        # RA/Dec is the geometric position precessed to J2000.
+        self.sun = pd.DataFrame([{
+            'Planet': 'Sun',
+            'Date': '1992-10-13 00:00:00.000',
+            'RA': 198.473554,
+            'Dec': -7.822251,
+            'RA_app': 198.378121,
+            'Dec_app': -7.783817
+        }])
+    
+    def test_meeus_example(self):
+        with mock.patch.object(self.fetcher, 'get_planet_positions', return_value=self.sun):
+            longitudes = self.fetcher.get_solar_longitudes('1992-10-13T00:00:00')
+        
+        # Apparent longitude 199°54'21.8"; aberration lowers it by about 20.3"
+        self.assertAlmostEqual(longitudes['apparent'], 199.906061, delta=2 / 3600)
+        self.assertAlmostEqual((longitudes['geometric'] - longitudes['apparent']) * 3600, 20.3, delta=1.5)
+    
+    def test_missing_sun_rejected(self):
+        with mock.patch.object(self.fetcher, 'get_planet_positions', return_value=pd.DataFrame()):
+            with self.assertRaises(RuntimeError):
+                self.fetcher.get_solar_longitudes('1992-10-13T00:00:00')
+    
+    def test_missing_apparent_position_rejected(self):
+        self.sun['RA_app'] = float('nan')
+        
+        with mock.patch.object(self.fetcher, 'get_planet_positions', return_value=self.sun):
+            with self.assertRaises(RuntimeError):
+                self.fetcher.get_solar_longitudes('1992-10-13T00:00:00')
+
+
+class TestProgressedLunarPhase(unittest.TestCase):
+    """Tests for the secondary-progressed lunar phase."""
+    
+    def setUp(self):
+        self.fetcher = AstroDataFetcher()
+        # Born at a new moon; the Moon gains about 12.19° a day on the Sun
+        self.models = {'Sun': linear_motion(250.0, 0.9856), 'Moon': linear_motion(250.0, 13.1764)}
+        self.birth = Time(JD0, format='jd').datetime
+    
+    def progressed_phase(self, years):
+        target = self.birth + timedelta(days=years * AstroDataFetcher.TROPICAL_YEAR)
+        with mock.patch('qucanft.astro_data.Horizons', fake_horizons(self.models)):
+            return self.fetcher.get_progressed_lunar_phase(self.birth, target)
+    
+    def test_phase_advances_every_few_years(self):
+        phases = [self.progressed_phase(years)['phase'] for years in (1, 4.7, 8.4, 12.1)]
+        
+        self.assertEqual(phases, ['New Moon', 'Waxing Crescent', 'First Quarter', 'Waxing Gibbous'])
+    
+    def test_angle_and_progressed_date(self):
+        progression = self.progressed_phase(10)
+        
+        # Ten years of life are ten days after birth
+        self.assertAlmostEqual(progression['angle'], 121.908, places=2)
+        self.assertEqual(progression['progressed_date'], Time(JD0 + 10, format='jd').iso)
+    
+    def test_missing_moon_rejected(self):
+        with mock.patch.object(self.fetcher, 'get_planet_positions', return_value=pd.DataFrame()):
+            with self.assertRaises(RuntimeError):
+                self.fetcher.get_progressed_lunar_phase(self.birth, '2030-01-01')
+
+
+class TestLocationDistance(unittest.TestCase):
+    """Tests for the distance between named or explicit locations."""
+    
+    def setUp(self):
+        self.fetcher = AstroDataFetcher()
+    
+    def test_named_locations(self):
+        self.assertAlmostEqual(self.fetcher.get_location_distance('New York, NY', 'London, UK'), 5570, delta=1)
+    
+    def test_mixed_location_forms(self):
+        london = {'lat': 51.5074, 'lon': -0.1278}
+        
+        self.assertAlmostEqual(self.fetcher.get_location_distance(london, 'London, UK'), 0)
+
+
+class TestLunarApsides(unittest.TestCase):
+    """Tests for finding the Moon's perigee and apogee."""
+    
+    def setUp(self):
+        self.fetcher = AstroDataFetcher()
+    
+    def test_closest_and_farthest_samples(self):
+        distances = [0.00260, 0.00238, 0.00245, 0.00271, 0.00266]
+        moon_data = pd.DataFrame([{
+            'datetime_str': Time(JD0 + day, format='jd').iso,
+            'datetime_jd': JD0 + day,
+            'delta': distance
+        } for day, distance in enumerate(distances)])
+        
+        with mock.patch.object(self.fetcher, 'get_ephemeris_range', return_value=moon_data):
+            apsides = self.fetcher.find_lunar_apsides(Time(JD0, format='jd'), Time(JD0 + 4, format='jd'))
+        
+        self.assertAlmostEqual(apsides['perigee']['jd'], JD0 + 1)
+        self.assertEqual(apsides['perigee']['datetime'], Time(JD0 + 1, format='jd').iso)
+        self.assertAlmostEqual(apsides['perigee']['distance_km'], 0.00238 * 149597870.7)
+        self.assertAlmostEqual(apsides['apogee']['jd'], JD0 + 3)
+        self.assertAlmostEqual(apsides['apogee']['distance_au'], 0.00271)
+    
+    def test_empty_range_rejected(self):
+        with mock.patch.object(self.fetcher, 'get_ephemeris_range', return_value=pd.DataFrame()):
+            with self.assertRaises(ValueError):
+                self.fetcher.find_lunar_apsides(Time(JD0, format='jd'), Time(JD0 + 4, format='jd'))
+
+
+class TestTransitsToPoint(unittest.TestCase):
+    """Tests for timing transits to a fixed degree."""
+    
+    def setUp(self):
+        self.fetcher = AstroDataFetcher()
+    
+    def test_square_to_fixed_degree(self):
+        models = {'Sun': linear_motion(270.0, 0.9856)}
+        
+        with patch_longitudes(self.fetcher, models):
+            transits = self.fetcher.find_transits_to_point('Sun', 190, 90,
+                                                           Time(JD0, format='jd'),
+                                                           Time(JD0 + 30, format='jd'))
+        
+        # Only the waxing square at 280° falls in the range
+        self.assertEqual(len(transits), 1)
+        self.assertAlmostEqual(transits[0]['jd'], JD0 + 10 / 0.9856, places=3)
+        self.assertAlmostEqual(transits[0]['longitude'], 280, places=2)
+        self.assertEqual(transits[0]['direction'], 'Direct')
+    
+    def test_retrograde_passes(self):
+        # A planet swinging 3° either side of 20° with a 20-day period
+        models = {'Mercury': lambda julian_day: 20 + 3 * math.sin(2 * math.pi * (julian_day - JD0) / 20)}
+        
+        with patch_longitudes(self.fetcher, models):
+            transits = self.fetcher.find_transits_to_point('Mercury', 21.5, 0,
+                                                           Time(JD0, format='jd'),
+                                                           Time(JD0 + 15, format='jd'))
+        
+        self.assertEqual([transit['direction'] for transit in transits], ['Direct', 'Retrograde'])
+        self.assertAlmostEqual(transits[0]['jd'], JD0 + 20 / 12, places=2)
+        self.assertAlmostEqual(transits[1]['jd'], JD0 + 10 - 20 / 12, places=2)
+    
+    def test_no_transit_in_range(self):
+        with patch_longitudes(self.fetcher, {'Sun': linear_motion(270.0, 0.9856)}):
+            transits = self.fetcher.find_transits_to_point('Sun', 0, 0,
+                                                           Time(JD0, format='jd'),
+                                                           Time(JD0 + 30, format='jd'))
+        
+        self.assertEqual(transits, [])
+
+
+class TestEquinoxesAndSolstices(unittest.TestCase):
+    """Tests for timing the equinoxes and solstices."""
+    
+    def setUp(self):
+        self.fetcher = AstroDataFetcher()
+        self.models = {'Sun': solar_longitude}
+    
+    def test_march_equinox_2024(self):
+        with patch_longitudes(self.fetcher, self.models):
+            equinoxes = self.fetcher.find_equinoxes(2024)
+        
+        # 2024 March 20, 03:06 UTC
+        expected = Time('2024-03-20T03:06:00').jd
+        self.assertAlmostEqual(equinoxes['march']['jd'], expected, delta=5 / 1440)
+        self.assertAlmostEqual((equinoxes['march']['longitude'] + 180) % 360 - 180, 0, delta=0.001)
+        self.assertTrue(equinoxes['september']['datetime'].startswith('2024-09-22'))
+    
+    def test_apparent_longitudes_requested(self):
+        with patch_longitudes(self.fetcher, self.models) as samples:
+            self.fetcher.find_equinoxes(2024, refine_step=None)
+        
+        self.assertTrue(all(call.kwargs.get('apparent') for call in samples.call_args_list))
+    
+    def test_solstices_2024(self):
+        with patch_longitudes(self.fetcher, self.models):
+            solstices = self.fetcher.find_solstices(2024)
+        
+        # 2024 June 20, 20:51 UTC and December 21, 09:21 UTC
+        self.assertAlmostEqual(solstices['june']['jd'], Time('2024-06-20T20:51:00').jd, delta=5 / 1440)
+        self.assertAlmostEqual(solstices['december']['jd'], Time('2024-12-21T09:21:00').jd,
+                               delta=10 / 1440)
+
+
+class TestParseDate(unittest.TestCase):
+    """Tests for reading query dates with UTC offsets."""
+    
+    def setUp(self):
+        self.fetcher = AstroDataFetcher()
+        self.utc_jd = Time('1990-06-15T19:30:00').jd
+    
+    def test_iso_offsets(self):
+        self.assertAlmostEqual(self.fetcher.parse_date('1990-06-15T14:30:00-05:00').jd, self.utc_jd)
+        self.assertAlmostEqual(self.fetcher.parse_date('1990-06-15T19:30:00Z').jd, self.utc_jd)
+        self.assertAlmostEqual(self.fetcher.parse_date('1990-06-16T01:00:00+05:30').jd, self.utc_jd)
+    
+    def test_naive_values_are_utc(self):
+        self.assertAlmostEqual(self.fetcher.parse_date('1990-06-15T19:30:00').jd, self.utc_jd)
+        self.assertAlmostEqual(self.fetcher.parse_date(datetime(1990, 6, 15, 19, 30)).jd, self.utc_jd)
+    
+    def test_aware_datetime(self):
+        eastern = timezone(timedelta(hours=-4))
+        
+        self.assertAlmostEqual(self.fetcher.parse_date(datetime(1990, 6, 15, 15, 30, tzinfo=eastern)).jd,
+                               self.utc_jd)
+    
+    def test_unparseable_date_rejected(self):
+        with self.assertRaises(ValueError):
+            self.fetcher.parse_date('next Tuesday')
+
+
+if __name__ == '__main__':
+    unittest.main()
\ No newline at end of file
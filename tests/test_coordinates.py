@@ -0,0 +1,90 @@
+"""
+Tests for the shared coordinate helpers.
+"""
+
+import unittest
+
+from qucanft.coordinates import (
+    EPSILON,
+    angular_separation,
+    coordinates_approx_equal,
+    interpolate_longitude,
+    location_distance,
+    normalize_angle,
+)
+
+
+class TestAngularSeparation(unittest.TestCase):
+    """Tests for the shortest arc between ecliptic positions."""
+    
+    def test_separation_across_aries_point(self):
+        self.assertAlmostEqual(angular_separation(350, 10), 20)
+        self.assertAlmostEqual(angular_separation(10, 350), 20)
+    
+    def test_unnormalized_inputs(self):
+        self.assertAlmostEqual(angular_separation(-10, 370), 20)
+        self.assertAlmostEqual(normalize_angle(-10), 350)
+    
+    def test_separation_never_exceeds_half_circle(self):
+        self.assertAlmostEqual(angular_separation(0, 180), 180)
+        self.assertAlmostEqual(angular_separation(30, 240), 150)
+
+
+class TestCoordinatesApproxEqual(unittest.TestCase):
+    """Tests for comparing coordinate pairs within a tolerance."""
+    
+    def test_equal_across_aries_point(self):
+        self.assertTrue(coordinates_approx_equal((359.9999999, 1.0), (0.0, 1.0)))
+        self.assertTrue(coordinates_approx_equal((0.0, -23.4), (359.9999999, -23.4)))
+    
+    def test_default_tolerance(self):
+        self.assertTrue(coordinates_approx_equal((10.0, 5.0), (10.0 + EPSILON / 2, 5.0)))
+        self.assertFalse(coordinates_approx_equal((10.0, 5.0), (10.0 + EPSILON * 2, 5.0)))
+        self.assertFalse(coordinates_approx_equal((10.0, 5.0), (10.0, 5.0 + EPSILON * 2)))
+    
+    def test_custom_tolerance(self):
+        self.assertTrue(coordinates_approx_equal((10.0, 5.0), (10.001, 4.999), eps=0.01))
+        self.assertFalse(coordinates_approx_equal((10.0, 5.0), (10.1, 5.0), eps=0.01))
+
+
+class TestLocationDistance(unittest.TestCase):
+    """Tests for the surface distance between geographic locations."""
+    
+    def test_new_york_to_london(self):
+        new_york = {'lat': 40.7128, 'lon': -74.0060}
+        london = {'lat': 51.5074, 'lon': -0.1278}
+        
+        self.assertAlmostEqual(location_distance(new_york, london), 5570, delta=1)
+        self.assertAlmostEqual(location_distance(london, new_york), location_distance(new_york, london))
+    
+    def test_quarter_meridian(self):
+        # Equator to pole is a quarter of the mean circumference
+        distance = location_distance({'lat': 0, 'lon': 30}, {'lat': 90, 'lon': -120})
+        
+        self.assertAlmostEqual(distance, 10007.5, delta=0.1)
+
+
+class TestInterpolateLongitude(unittest.TestCase):
+    """Tests for three-point interpolation of sparse ephemerides."""
+    
+    def test_quadratic_motion_is_exact(self):
+        samples = {2451545.0 + t: 10 + 2 * t + 0.1 * t ** 2 for t in range(5)}
+        
+        self.assertAlmostEqual(interpolate_longitude(samples, 2451545.5), 11.025)
+        self.assertAlmostEqual(interpolate_longitude(samples, 2451548.25), 10 + 6.5 + 0.1 * 3.25 ** 2)
+    
+    def test_across_aries_point(self):
+        samples = {2451545.0: 359.0, 2451546.0: 0.5, 2451547.0: 2.0}
+        
+        self.assertAlmostEqual(interpolate_longitude(samples, 2451546.5), 1.25)
+        self.assertAlmostEqual(interpolate_longitude(samples, 2451545.5), 359.75)
+    
+    def test_invalid_requests(self):
+        with self.assertRaises(ValueError):
+            interpolate_longitude({2451545.0: 10.0, 2451546.0: 11.0}, 2451545.5)
+        with self.assertRaises(ValueError):
+            interpolate_longitude({2451545.0: 10.0, 2451546.0: 11.0, 2451547.0: 12.0}, 2451548.0)
+
+
+if __name__ == '__main__':
+    unittest.main()
@@ -0,0 +1,804 @@
+"""
+Tests for the astrological houses module.
+"""
+
+import math
+import unittest
+from datetime import datetime, timedelta, timezone
+
+from qucanft import AspectsCalculator, HousesCalculator, ZodiacCalculator
+from tests.helpers import make_planetary_data
+
+
+def brute_force_placidus_cusp(ramc, latitude, house_num, obliquity=23.4367):
+    """
+    Find a Placidus cusp by scanning the ecliptic for its hour-angle condition.
+    
+    A cusp above the horizon has an hour angle of -1/3 (11th) or -2/3 (12th)
+    of its diurnal semi-arc; one below has travelled 1/3 (3rd) or 2/3 (2nd)
+    of its nocturnal semi-arc past the lower meridian.
+    """
+    eps = math.radians(obliquity)
+    tan_lat = math.tan(math.radians(latitude))
+    
+    def condition(longitude):
+        lon = math.radians(longitude)
+        ra = math.degrees(math.atan2(math.sin(lon) * math.cos(eps), math.cos(lon)))
+        dec = math.asin(math.sin(eps) * math.sin(lon))
+        diurnal = math.degrees(math.acos(-tan_lat * math.tan(dec)))
+        hour_angle = ramc - ra
+        target = {
+            11: -diurnal / 3,
+            12: -2 * diurnal / 3,
+            2: 180 + 2 * (180 - diurnal) / 3,
+            3: 180 + (180 - diurnal) / 3
+        }[house_num]
+        return (hour_angle - target + 180) % 360 - 180
+    
+    roots = []
+    for tenth in range(3600):
+        low, high = tenth / 10, (tenth + 1) / 10
+        f_low, f_high = condition(low), condition(high)
+        if f_low * f_high > 0 or abs(f_low - f_high) > 10:
+            continue
+        for _ in range(60):
+            middle = (low + high) / 2
+            if condition(low) * condition(middle) <= 0:
+                high = middle
+            else:
+                low = middle
+        roots.append((low + high) / 2)
+    
+    return roots
+
+
+class TestSiderealTime(unittest.TestCase):
+    """Tests for local sidereal time (Meeus, Astronomical Algorithms, ch. 12)."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+    
+    def test_greenwich_sidereal_time(self):
+        # Example 12.a: 1987 April 10, 0h UT -> 13h10m46.3668s
+        sidereal_time = self.calculator.calculate_local_sidereal_time(2446895.5, 0)
+        
+        self.assertAlmostEqual(sidereal_time, 13 + 10 / 60 + 46.3668 / 3600, places=5)
+        self.assertEqual(self.calculator.local_sidereal_time_hms(2446895.5, 0), (13, 10, 46))
+    
+    def test_sidereal_time_at_meridian(self):
+        # Example 12.b: 1987 April 10, 19h21m UT -> 8h34m57.0896s at Greenwich
+        self.assertEqual(self.calculator.local_sidereal_time_hms(2446896.30625, 0), (8, 34, 57))
+        self.assertEqual(self.calculator.local_sidereal_time_hms(2446896.30625, 15), (9, 34, 57))
+        self.assertEqual(self.calculator.local_sidereal_time_hms(2446896.30625, -135), (23, 34, 57))
+    
+    def test_hms_wraps_at_midnight(self):
+        self.assertEqual(self.calculator.sidereal_time_to_hms(23.99999), (0, 0, 0))
+        self.assertEqual(self.calculator.sidereal_time_to_hms(25.5), (1, 30, 0))
+
+
+class TestCuspOrb(unittest.TestCase):
+    """Tests for counting planets just before a cusp in the next house."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+        self.house_cusps = self.calculator.calculate_equal_houses(0)
+    
+    def test_planet_before_cusp_promoted(self):
+        self.assertEqual(self.calculator.determine_planet_house(27, self.house_cusps), 1)
+        self.assertEqual(self.calculator.determine_planet_house(27, self.house_cusps, cusp_orb=5), 2)
+        self.assertEqual(self.calculator.determine_planet_house(20, self.house_cusps, cusp_orb=5), 1)
+    
+    def test_promotion_across_aries_point(self):
+        self.assertEqual(self.calculator.determine_planet_house(357, self.house_cusps), 12)
+        self.assertEqual(self.calculator.determine_planet_house(357, self.house_cusps, cusp_orb=5), 1)
+    
+    def test_house_positions_use_cusp_orb(self):
+        planetary_data = make_planetary_data({'Sun': 27, 'Moon': 100})
+        
+        positions = self.calculator.add_house_positions(planetary_data, self.house_cusps, cusp_orb=5)
+        
+        self.assertEqual(list(positions['House']), [2, 4])
+
+
+class TestCompareHouseSystems(unittest.TestCase):
+    """Tests for calculating several house systems at once."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+        # London with the vernal point culminating
+        self.latitude = 51.5
+        self.ascendant = self.calculator.calculate_ascendant(0, self.latitude)
+        self.midheaven = self.calculator.calculate_midheaven(0)
+    
+    def test_all_implemented_systems_by_default(self):
+        results = self.calculator.compare_house_systems(self.ascendant, self.midheaven, self.latitude)
+        
+        expected = set(HousesCalculator.HOUSE_SYSTEMS) - set(HousesCalculator.UNIMPLEMENTED_HOUSE_SYSTEMS)
+        self.assertEqual(set(results), expected)
+        self.assertAlmostEqual(results['equal'][1], self.ascendant)
+        self.assertEqual(results['whole'][1], 90)
+        self.assertEqual(results['natural'][1], 0)
+        self.assertAlmostEqual(results['placidus'][10], self.midheaven)
+    
+    def test_placement_across_systems(self):
+        placements = self.calculator.house_placement_across_systems(
+            100, self.ascendant, self.midheaven, self.latitude, ['equal', 'whole']
+        )
+        
+        self.assertEqual(placements, {'equal': 12, 'whole': 1})
+    
+    def test_placement_in_every_system_by_default(self):
+        placements = self.calculator.house_placement_across_systems(
+            100, self.ascendant, self.midheaven, self.latitude
+        )
+        
+        self.assertEqual(set(placements),
+                         set(self.calculator.compare_house_systems(self.ascendant, self.midheaven, self.latitude)))
+        self.assertEqual(placements['natural'], 4)
+        self.assertEqual(placements['placidus'], 12)
+    
+    def test_unimplemented_system_rejected(self):
+        with self.assertRaises(ValueError):
+            self.calculator.compare_house_systems(self.ascendant, house_systems=['campanus'])
+    
+    def test_unknown_system_rejected(self):
+        with self.assertRaises(ValueError):
+            self.calculator.compare_house_systems(self.ascendant, house_systems=['alcabitius'])
+
+
+class TestSemiArcCusps(unittest.TestCase):
+    """Tests for the semi-arc geometry shared by Placidus and Koch."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+    
+    def test_semi_arc_cusp_hour_angles(self):
+        # On the celestial equator the semi-arc is 90° at any latitude
+        self.assertAlmostEqual(self.calculator._semi_arc_cusp(0, 0, 51.5, 1 / 3), 30)
+        # cos(SA) = -tan(51.5°) tan(20°) gives SA = 117.2306°
+        self.assertAlmostEqual(self.calculator._semi_arc_cusp(0, 20, 51.5, 1 / 3), 39.0769, places=4)
+        # cos(SA) = -tan(40°) tan(-15°) gives SA = 77.0068°
+        self.assertAlmostEqual(self.calculator._semi_arc_cusp(100, -15, 40, -2 / 3), 48.6622, places=4)
+        self.assertAlmostEqual(self.calculator._semi_arc_cusp(350, 0, 40, 1 / 3), 20)
+    
+    def test_circumpolar_declination_rejected(self):
+        with self.assertRaises(ValueError):
+            self.calculator._semi_arc_cusp(0, 40, 60, 1 / 3)
+    
+    def test_ascendant_rises_in_the_east(self):
+        # With the vernal point culminating at the equator, 0° Cancer rises
+        self.assertAlmostEqual(self.calculator.calculate_ascendant(0, 0), 90)
+        self.assertAlmostEqual(self.calculator.calculate_ascendant(12, 0), 270)
+        self.assertAlmostEqual(self.calculator.calculate_ascendant(0, 51.5), 116.6, delta=0.05)
+    
+    def test_midheaven_culminates(self):
+        self.assertAlmostEqual(self.calculator.calculate_midheaven(0), 0)
+        self.assertAlmostEqual(self.calculator.calculate_midheaven(6), 90)
+        self.assertAlmostEqual(self.calculator.calculate_midheaven(18), 270)
+        # RA 30° lies at ecliptic longitude 32.2°
+        self.assertAlmostEqual(self.calculator.calculate_midheaven(2), 32.2, delta=0.05)
+
+
+class TestPlacidusHouses(unittest.TestCase):
+    """Tests for Placidus cusps found by semi-arc iteration."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+    
+    def placidus_for(self, sidereal_time, latitude):
+        ascendant = self.calculator.calculate_ascendant(sidereal_time, latitude)
+        midheaven = self.calculator.calculate_midheaven(sidereal_time)
+        return self.calculator.calculate_placidus_houses(ascendant, midheaven, latitude)
+    
+    def test_london_table_of_houses(self):
+        # Tables of houses for London at RAMC 0h: 11th 9° Taurus, 12th 22° Gemini,
+        # ASC 26°36' Cancer, 2nd 12° Leo, 3rd 3° Virgo
+        cusps = self.placidus_for(0, 51.5)
+        
+        self.assertAlmostEqual(cusps[10], 0)
+        self.assertAlmostEqual(cusps[11], 39, delta=0.5)
+        self.assertAlmostEqual(cusps[12], 82, delta=0.5)
+        self.assertAlmostEqual(cusps[1], 116.6, delta=0.1)
+        self.assertAlmostEqual(cusps[2], 132.6, delta=0.5)
+        self.assertAlmostEqual(cusps[3], 152.6, delta=0.5)
+    
+    def test_cusps_match_brute_force(self):
+        for latitude in (51.5, 40, -33):
+            for sidereal_time in (0, 5, 13.5, 20):
+                cusps = self.placidus_for(sidereal_time, latitude)
+                for house_num in (11, 12, 2, 3):
+                    with self.subTest(latitude=latitude, sidereal_time=sidereal_time, house=house_num):
+                        roots = brute_force_placidus_cusp(sidereal_time * 15, latitude, house_num)
+                        self.assertTrue(any(abs((root - cusps[house_num] + 180) % 360 - 180) < 1e-6
+                                            for root in roots))
+    
+    def test_opposite_cusps(self):
+        cusps = self.placidus_for(5, -33)
+        
+        for house_num in range(1, 7):
+            self.assertAlmostEqual((cusps[house_num + 6] - cusps[house_num]) % 360, 180)
+    
+    def test_cusps_in_zodiacal_order(self):
+        for latitude in (51.5, 40, -33):
+            cusps = self.placidus_for(13.5, latitude)
+            arcs = [(cusps[house_num % 12 + 1] - cusps[house_num]) % 360 for house_num in range(1, 13)]
+            
+            self.assertAlmostEqual(sum(arcs), 360)
+    
+    def test_koch_matches_placidus_at_equator(self):
+        # Every semi-arc is 90° on the equator, so both systems trisect alike
+        ascendant = self.calculator.calculate_ascendant(7, 0)
+        midheaven = self.calculator.calculate_midheaven(7)
+        placidus = self.calculator.calculate_placidus_houses(ascendant, midheaven, 0)
+        koch = self.calculator.calculate_koch_houses(ascendant, midheaven, 0)
+        
+        for house_num in range(1, 13):
+            self.assertAlmostEqual(koch[house_num], placidus[house_num])
+
+
+class TestEqualHousesFrom(unittest.TestCase):
+    """Tests for equal houses measured from a chosen degree."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+    
+    def test_cusps_from_custom_start(self):
+        cusps = self.calculator.calculate_equal_houses_from(15)
+        
+        self.assertEqual(cusps[1], 15)
+        self.assertEqual(cusps[4], 105)
+        self.assertEqual(cusps[12], 345)
+    
+    def test_cusps_wrap_past_aries(self):
+        cusps = self.calculator.calculate_equal_houses_from(350)
+        
+        self.assertEqual(cusps[2], 20)
+        self.assertEqual(cusps[7], 170)
+    
+    def test_natural_houses_start_at_aries(self):
+        cusps = self.calculator.calculate_houses(123.4, house_system='natural')
+        
+        self.assertEqual(cusps, {house_num: (house_num - 1) * 30 for house_num in range(1, 13)})
+
+
+class TestAngularPlanets(unittest.TestCase):
+    """Tests for planets conjunct the chart angles."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+    
+    def test_chart_angles(self):
+        self.assertEqual(self.calculator.get_chart_angles(100, 10),
+                         {'ASC': 100, 'MC': 10, 'DESC': 280, 'IC': 190})
+    
+    def test_planets_on_each_angle(self):
+        planetary_data = make_planetary_data({
+            'Sun': 98, 'Moon': 12, 'Venus': 282, 'Mars': 188, 'Jupiter': 50
+        })
+        
+        angular = self.calculator.find_angular_planets(planetary_data, 100, 10)
+        
+        self.assertEqual(angular, {'ASC': ['Sun'], 'MC': ['Moon'], 'DESC': ['Venus'], 'IC': ['Mars']})
+    
+    def test_orb_across_aries_point(self):
+        planetary_data = make_planetary_data({'Sun': 2, 'Moon': 351})
+        
+        angular = self.calculator.find_angular_planets(planetary_data, 90, 358)
+        self.assertEqual(angular['MC'], ['Sun'])
+        
+        angular = self.calculator.find_angular_planets(planetary_data, 90, 358, orb=8)
+        self.assertEqual(angular['MC'], ['Sun', 'Moon'])
+
+
+class TestTopocentricHouses(unittest.TestCase):
+    """Tests for the Topocentric (Polich-Page) house system."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+    
+    def cusps_for(self, sidereal_time, latitude):
+        ascendant = self.calculator.calculate_ascendant(sidereal_time, latitude)
+        midheaven = self.calculator.calculate_midheaven(sidereal_time)
+        return (self.calculator.calculate_topocentric_houses(ascendant, midheaven, latitude),
+                self.calculator.calculate_placidus_houses(ascendant, midheaven, latitude))
+    
+    def test_matches_placidus_at_equator(self):
+        topocentric, placidus = self.cusps_for(9, 0)
+        
+        for house_num in range(1, 13):
+            self.assertAlmostEqual(topocentric[house_num], placidus[house_num])
+    
+    def test_close_to_placidus_at_moderate_latitudes(self):
+        # The two systems agree to within a degree outside the polar regions
+        for latitude in (51.5, 40, -33):
+            for sidereal_time in (0, 5, 13.5):
+                topocentric, placidus = self.cusps_for(sidereal_time, latitude)
+                for house_num in range(1, 13):
+                    difference = (topocentric[house_num] - placidus[house_num] + 180) % 360 - 180
+                    self.assertLess(abs(difference), 1)
+    
+    def test_requires_midheaven_and_latitude(self):
+        with self.assertRaises(ValueError):
+            self.calculator.calculate_houses(100, midheaven=10, house_system='topocentric')
+
+
+class TestHyleg(unittest.TestCase):
+    """Tests for finding the hyleg from the aphetic places."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+        self.house_cusps = self.calculator.calculate_equal_houses(0)
+    
+    def hyleg(self, sun, moon):
+        return self.calculator.find_hyleg(make_planetary_data({'Sun': sun, 'Moon': moon}),
+                                          self.house_cusps, 0)
+    
+    def test_sect_light_preferred(self):
+        # Sun in the 10th by day
+        self.assertEqual(self.hyleg(280, 10), 'Sun')
+        # Moon in the 11th by night
+        self.assertEqual(self.hyleg(100, 310), 'Moon')
+    
+    def test_other_luminary_when_sect_light_fails(self):
+        # Sun in the 8th by day, Moon in the 1st
+        self.assertEqual(self.hyleg(220, 10), 'Moon')
+    
+    def test_ascendant_when_neither_luminary_qualifies(self):
+        # Sun in the 2nd and Moon in the 4th by night
+        self.assertEqual(self.hyleg(50, 100), 'Ascendant')
+    
+    def test_luminaries_required(self):
+        with self.assertRaises(ValueError):
+            self.calculator.find_hyleg(make_planetary_data({'Sun': 280}), self.house_cusps, 0)
+    
+    def test_sect_from_ascendant_with_whole_sign_houses(self):
+        # Ascendant 20° Aries: the Sun at 10° Aries has already risen, so the
+        # chart is diurnal although the Sun is in the 1st whole sign house
+        house_cusps = self.calculator.calculate_whole_sign_houses(20)
+        planetary_data = make_planetary_data({'Sun': 10, 'Moon': 200})
+        
+        self.assertTrue(self.calculator.is_day_chart(10, 20))
+        self.assertEqual(self.calculator.find_hyleg(planetary_data, house_cusps, 20), 'Sun')
+    
+    def test_day_chart_from_ascendant(self):
+        self.assertTrue(self.calculator.is_day_chart(280, 0))
+        self.assertTrue(self.calculator.is_day_chart(180, 0))
+        self.assertFalse(self.calculator.is_day_chart(90, 0))
+        self.assertFalse(self.calculator.is_day_chart(30, 20))
+
+
+class TestMeridianHouses(unittest.TestCase):
+    """Tests for equal houses in right ascension from the MC."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+    
+    def test_cusps_from_vernal_midheaven(self):
+        cusps = self.calculator.calculate_meridian_houses(0)
+        
+        self.assertAlmostEqual(cusps[10], 0)
+        self.assertAlmostEqual(cusps[1], 90)
+        self.assertAlmostEqual(cusps[4], 180)
+        self.assertAlmostEqual(cusps[7], 270)
+        # RA 30° lies at ecliptic longitude 32.2°
+        self.assertAlmostEqual(cusps[11], 32.2, delta=0.05)
+    
+    def test_ascendant_floats_free(self):
+        cusps = self.calculator.calculate_houses(116.6, midheaven=0, house_system='meridian')
+        
+        self.assertAlmostEqual(cusps[1], 90)
+    
+    def test_requires_midheaven(self):
+        with self.assertRaises(ValueError):
+            self.calculator.calculate_houses(116.6, house_system='meridian')
+
+
+class TestProgressedAscendant(unittest.TestCase):
+    """Tests for the Naibod-arc progressed Ascendant."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+        self.natal_time = datetime(1990, 6, 15, 12, 0)
+    
+    def test_julian_day(self):
+        self.assertAlmostEqual(self.calculator.datetime_to_julian_day(datetime(2000, 1, 1, 12)), 2451545.0)
+        
+        # Aware values are converted to UTC first
+        paris = timezone(timedelta(hours=1))
+        self.assertAlmostEqual(
+            self.calculator.datetime_to_julian_day(datetime(2000, 1, 1, 13, tzinfo=paris)), 2451545.0)
+    
+    def test_no_progression_at_birth(self):
+        natal_lst = self.calculator.calculate_local_sidereal_time(
+            self.calculator.datetime_to_julian_day(self.natal_time), -0.1)
+        
+        progressed = self.calculator.calculate_progressed_ascendant(
+            self.natal_time, self.natal_time, 51.5, -0.1)
+        
+        self.assertAlmostEqual(progressed, self.calculator.calculate_ascendant(natal_lst, 51.5))
+    
+    def test_thirty_years_of_naibod_arc(self):
+        natal_lst = self.calculator.calculate_local_sidereal_time(
+            self.calculator.datetime_to_julian_day(self.natal_time), -0.1)
+        target_time = self.natal_time + timedelta(days=30 * 365.242189)
+        
+        progressed = self.calculator.calculate_progressed_ascendant(
+            self.natal_time, target_time, 51.5, -0.1)
+        
+        # 30 years at 0.98564733° a year moves the RAMC by about 29.57°
+        expected_lst = (natal_lst + 30 * 0.98564733 / 15) % 24
+        self.assertAlmostEqual(progressed, self.calculator.calculate_ascendant(expected_lst, 51.5), places=6)
+    
+    def test_target_before_birth_rejected(self):
+        with self.assertRaises(ValueError):
+            self.calculator.calculate_progressed_ascendant(
+                self.natal_time, self.natal_time - timedelta(days=1), 51.5, -0.1)
+
+
+class TestEquationOfTime(unittest.TestCase):
+    """Tests for the equation of time and local apparent time."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+    
+    def test_seasonal_extremes(self):
+        # Sundials run about 14 minutes slow in mid-February and 16.5 fast in early November
+        self.assertAlmostEqual(self.calculator.calculate_equation_of_time(2459986.5), -14.2, delta=0.1)
+        self.assertAlmostEqual(self.calculator.calculate_equation_of_time(2460251.5), 16.5, delta=0.1)
+    
+    def test_local_apparent_time(self):
+        # 2023 November 3, 12h UT
+        greenwich = self.calculator.local_apparent_time(2460252.0, 0)
+        chicago = self.calculator.local_apparent_time(2460252.0, -90)
+        
+        self.assertEqual((greenwich.hour, greenwich.minute), (12, 16))
+        self.assertEqual((chicago.hour, chicago.minute), (6, 16))
+
+
+class TestRelocation(unittest.TestCase):
+    """Tests for recasting a chart's angles and houses for other places."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+        # J2000.0, when Greenwich sidereal time is 18h41m50.5s
+        self.julian_day = 2451545.0
+        self.planetary_data = make_planetary_data({'Sun': 280.46, 'Moon': 223.32})
+    
+    def test_chart_frame(self):
+        frame = self.calculator.calculate_chart_frame(self.julian_day, 51.5, 0)
+        
+        self.assertAlmostEqual(frame['local_sidereal_time'], 18.697375, places=5)
+        self.assertAlmostEqual(frame['ascendant'], self.calculator.calculate_ascendant(18.697375, 51.5), places=3)
+        self.assertAlmostEqual(frame['midheaven'], self.calculator.calculate_midheaven(18.697375), places=3)
+        self.assertAlmostEqual(frame['houses'][1], frame['ascendant'])
+    
+    def test_relocation_keeps_longitudes(self):
+        relocated, frame = self.calculator.relocate_chart(self.planetary_data, self.julian_day,
+                                                          {'lat': 40.7128, 'lon': -74.0060})
+        
+        self.assertAlmostEqual(frame['local_sidereal_time'], (18.697375 - 74.0060 / 15) % 24, places=5)
+        self.assertEqual(relocated['Ecliptic_Longitude'].tolist(), [280.46, 223.32])
+        for longitude, house in zip(relocated['Ecliptic_Longitude'], relocated['House']):
+            self.assertEqual(house, int((longitude - frame['ascendant']) % 360 // 30) + 1)
+    
+    def test_charts_for_several_locations(self):
+        locations = [{'lat': 51.5074, 'lon': -0.1278}, {'lat': -33.8688, 'lon': 151.2093}]
+        
+        charts = self.calculator.calculate_charts_for_locations(self.planetary_data, self.julian_day, locations)
+        
+        self.assertEqual(len(charts), 2)
+        for (relocated, frame), location in zip(charts, locations):
+            expected = self.calculator.relocate_chart(self.planetary_data, self.julian_day, location)[1]
+            self.assertAlmostEqual(frame['ascendant'], expected['ascendant'])
+        # Same moment, so sidereal time differs only by the longitude difference
+        lst_difference = charts[1][1]['local_sidereal_time'] - charts[0][1]['local_sidereal_time']
+        self.assertAlmostEqual(lst_difference % 24, (151.2093 + 0.1278) / 15, places=6)
+
+
+class TestAccidentalDignity(unittest.TestCase):
+    """Tests for accidental dignity from houses and angular contacts."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+        self.cusps = self.calculator.calculate_houses(0, house_system='equal')
+    
+    def test_house_and_angle_scores(self):
+        planetary_data = make_planetary_data({'Sun': 2, 'Moon': 62, 'Mars': 340, 'Venus': 152})
+        
+        scores = self.calculator.calculate_accidental_dignity(planetary_data, self.cusps, 0, 270)
+        
+        # Sun: 1st house and conjunct the Ascendant
+        self.assertEqual(scores['Sun'], 10)
+        # Moon: 3rd house and sextile the Ascendant
+        self.assertEqual(scores['Moon'], 3)
+        # Mars: 12th house with no contacts
+        self.assertEqual(scores['Mars'], -5)
+        # Venus: 6th house and trine the Midheaven
+        self.assertEqual(scores['Venus'], 0)
+    
+    def test_custom_orb(self):
+        scores = self.calculator.calculate_accidental_dignity(make_planetary_data({'Moon': 62}),
+                                                              self.cusps, 0, 270, orb=1)
+        
+        self.assertEqual(scores['Moon'], 1)
+
+
+class TestStrongestPlanet(unittest.TestCase):
+    """Tests for ranking planets by combined dignity."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+        self.cusps = self.calculator.calculate_houses(0, house_system='equal')
+        # Mars rules Aries and sits on the Ascendant; Saturn rules Capricorn
+        # from the 10th house and Jupiter is exalted in the 4th
+        self.planetary_data = make_planetary_data({'Jupiter': 100, 'Saturn': 285,
+                                                   'Mars': 2, 'Venus': 175})
+    
+    def test_ruler_on_angle_wins(self):
+        planet, score = self.calculator.find_strongest_planet(self.planetary_data, self.cusps, 0, 270)
+        
+        # Rulership and face (6) plus 1st house and conjunct the Ascendant (10)
+        self.assertEqual(planet, 'Mars')
+        self.assertEqual(score, 16)
+    
+    def test_aspects_add_to_score(self):
+        aspects_df = AspectsCalculator().calculate_all_aspects(self.planetary_data)
+        
+        planet, score = self.calculator.find_strongest_planet(self.planetary_data, self.cusps,
+                                                              0, 270, aspects_df)
+        
+        # Mars squares Jupiter and opposes Venus
+        self.assertEqual(planet, 'Mars')
+        self.assertEqual(score, 18)
+    
+    def test_empty_chart_rejected(self):
+        with self.assertRaises(ValueError):
+            self.calculator.find_strongest_planet(make_planetary_data({}), self.cusps, 0, 270)
+
+
+class TestPartOfFortune(unittest.TestCase):
+    """Tests for the sect-aware Part of Fortune."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+        self.cusps = self.calculator.calculate_houses(0, house_system='equal')
+    
+    def test_day_chart(self):
+        # The Sun in the 7th house is above the horizon: ASC + Moon - Sun
+        self.assertAlmostEqual(self.calculator.calculate_part_of_fortune(200, 230, 0), 30)
+        self.assertEqual(self.calculator.get_part_of_fortune_house(200, 230, 0, self.cusps), 2)
+    
+    def test_night_chart(self):
+        # The Sun in the 1st house is below the horizon: ASC + Sun - Moon
+        self.assertAlmostEqual(self.calculator.calculate_part_of_fortune(20, 50, 0), 330)
+        self.assertEqual(self.calculator.get_part_of_fortune_house(20, 50, 0, self.cusps), 12)
+    
+    def test_whole_sign_houses_use_the_ascendant(self):
+        # Ascendant 20° Aries with the first whole sign cusp at 0°: the Sun
+        # at 10° Aries has risen, so by day ASC + Moon - Sun = 110°
+        cusps = self.calculator.calculate_whole_sign_houses(20)
+        
+        self.assertAlmostEqual(self.calculator.calculate_part_of_fortune(10, 100, 20), 110)
+        self.assertEqual(self.calculator.get_part_of_fortune_house(10, 100, 20, cusps), 4)
+
+
+class TestHybridHousePlacement(unittest.TestCase):
+    """Tests for Whole Sign topics with Placidus strength."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+        # London with the vernal point culminating: the Ascendant is in Cancer
+        self.latitude = 51.5
+        self.ascendant = self.calculator.calculate_ascendant(0, self.latitude)
+        self.midheaven = self.calculator.calculate_midheaven(0)
+        self.placidus = self.calculator.calculate_placidus_houses(self.ascendant, self.midheaven, self.latitude)
+    
+    def test_systems_can_disagree(self):
+        placements = self.calculator.calculate_hybrid_house_placement(
+            make_planetary_data({'Moon': 100}), self.ascendant, self.midheaven, self.latitude)
+        
+        moon = placements['Moon']
+        self.assertEqual(moon['whole_sign_house'], 1)
+        self.assertEqual(moon['placidus_house'], 12)
+        expected = 1 - (100 - self.placidus[12]) / (self.ascendant - self.placidus[12])
+        self.assertAlmostEqual(moon['placidus_strength'], expected)
+    
+    def test_strength_is_highest_on_cusp(self):
+        placements = self.calculator.calculate_hybrid_house_placement(
+            make_planetary_data({'Sun': self.midheaven, 'Mars': self.placidus[11] - 0.01}),
+            self.ascendant, self.midheaven, self.latitude)
+        
+        self.assertEqual(placements['Sun']['placidus_house'], 10)
+        self.assertEqual(placements['Sun']['whole_sign_house'], 10)
+        self.assertAlmostEqual(placements['Sun']['placidus_strength'], 1)
+        self.assertLess(placements['Mars']['placidus_strength'], 0.01)
+
+
+class TestPorphyryHouses(unittest.TestCase):
+    """Tests for the Porphyry and Sripati house systems."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+    
+    def test_porphyry_trisects_quadrants(self):
+        cusps = self.calculator.calculate_houses(100, midheaven=340, house_system='porphyry')
+        
+        expected = [100, 120, 140, 160, 200, 240, 280, 300, 320, 340, 20, 60]
+        for house_num, cusp in enumerate(expected, start=1):
+            self.assertAlmostEqual(cusps[house_num], cusp)
+    
+    def test_sripati_cusps_between_porphyry_cusps(self):
+        cusps = self.calculator.calculate_houses(100, midheaven=340, house_system='sripati')
+        
+        self.assertAlmostEqual(cusps[1], 80)
+        self.assertAlmostEqual(cusps[2], 110)
+        self.assertAlmostEqual(cusps[4], 150)
+        self.assertAlmostEqual(cusps[7], 260)
+        self.assertAlmostEqual(cusps[10], 330)
+        # The midpoint across 0° Aries
+        self.assertAlmostEqual(cusps[11], 0)
+    
+    def test_requires_midheaven(self):
+        for house_system in ('porphyry', 'sripati'):
+            with self.assertRaises(ValueError):
+                self.calculator.calculate_houses(100, house_system=house_system)
+
+
+class TestCuspFormatting(unittest.TestCase):
+    """Tests for writing house cusps in sign notation."""
+    
+    def test_tropical_cusps(self):
+        calculator = HousesCalculator()
+        
+        self.assertEqual(calculator.format_cusp_in_sign(45), "15°00' Taurus")
+        self.assertEqual(calculator.format_cusp_in_sign(359.5), "29°30' Pisces")
+        self.assertEqual(calculator.format_house_cusp(2, 45.5), "House 2: 15°30' Taurus")
+    
+    def test_shared_zodiac_offset(self):
+        sidereal = ZodiacCalculator()
+        calculator = HousesCalculator(zodiac_calculator=sidereal)
+        
+        # The offset is read from the shared calculator when formatting
+        sidereal.set_reference_offset(24)
+        
+        self.assertEqual(calculator.format_cusp_in_sign(45.5), "21°30' Aries")
+
+
+class TestDerivedHouses(unittest.TestCase):
+    """Tests for turning the chart to a derived 1st house."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+        self.cusps = self.calculator.calculate_houses(15, house_system='equal')
+    
+    def test_turned_to_seventh(self):
+        derived = self.calculator.calculate_derived_houses(self.cusps, 7)
+        
+        self.assertEqual(derived[1], self.cusps[7])
+        self.assertEqual(derived[6], self.cusps[12])
+        self.assertEqual(derived[7], self.cusps[1])
+        self.assertEqual(derived[12], self.cusps[6])
+        self.assertEqual(self.calculator.calculate_derived_houses(self.cusps, 1), self.cusps)
+    
+    def test_derived_angles(self):
+        angles = self.calculator.get_derived_angles(self.cusps, 7)
+        
+        self.assertAlmostEqual(angles['ASC'], 195)
+        self.assertAlmostEqual(angles['MC'], 105)
+        self.assertAlmostEqual(angles['DESC'], 15)
+        self.assertAlmostEqual(angles['IC'], 285)
+    
+    def test_invalid_house_rejected(self):
+        for new_first in (0, 13):
+            with self.assertRaises(ValueError):
+                self.calculator.calculate_derived_houses(self.cusps, new_first)
+
+
+class TestAntiscionHouse(unittest.TestCase):
+    """Tests for the house of a planet's antiscion."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+        self.cusps = self.calculator.calculate_houses(0, house_system='equal')
+    
+    def test_reflection_across_solstice_axis(self):
+        # 10° Aries reflects to 20° Virgo, and 10° Cancer to 20° Gemini
+        self.assertEqual(self.calculator.get_antiscion_house(10, self.cusps), 6)
+        self.assertEqual(self.calculator.get_antiscion_house(100, self.cusps), 3)
+    
+    def test_points_on_axis_reflect_to_themselves(self):
+        self.assertEqual(self.calculator.get_antiscion_house(90, self.cusps), 4)
+        self.assertEqual(self.calculator.get_antiscion_house(270, self.cusps), 10)
+
+
+class TestInterceptedSigns(unittest.TestCase):
+    """Tests for signs wholly contained within a house."""
+    
+    def setUp(self):
+        self.cusps = dict(enumerate([0, 20, 70, 90, 110, 140, 180, 200, 250, 270, 290, 320], start=1))
+    
+    def test_tropical_interceptions(self):
+        intercepted = HousesCalculator().find_intercepted_signs(self.cusps)
+        
+        self.assertEqual(intercepted, {2: ['Taurus'], 6: ['Virgo'], 8: ['Scorpio'], 12: ['Pisces']})
+    
+    def test_equal_houses_have_none(self):
+        calculator = HousesCalculator()
+        
+        self.assertEqual(calculator.find_intercepted_signs(calculator.calculate_houses(15, house_system='equal')), {})
+    
+    def test_interceptions_with_offset(self):
+        sidereal = ZodiacCalculator()
+        sidereal.set_reference_offset(24)
+        expected = {2: ['Aries'], 6: ['Leo'], 8: ['Libra'], 12: ['Aquarius']}
+        
+        self.assertEqual(HousesCalculator(zodiac_calculator=sidereal).find_intercepted_signs(self.cusps), expected)
+        self.assertEqual(HousesCalculator().find_intercepted_signs(self.cusps, sidereal), expected)
+
+
+class TestSectLight(unittest.TestCase):
+    """Tests for the luminary of the chart's sect."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+    
+    def test_sun_above_horizon(self):
+        self.assertEqual(self.calculator.get_sect_light(200, 0), 'Sun')
+    
+    def test_sun_below_horizon(self):
+        self.assertEqual(self.calculator.get_sect_light(20, 0), 'Moon')
+    
+    def test_sun_risen_within_first_sign(self):
+        # The Sun 10° behind a 20° Aries Ascendant is above the horizon
+        self.assertEqual(self.calculator.get_sect_light(10, 20), 'Sun')
+
+
+class TestDistanceToAngles(unittest.TestCase):
+    """Tests for a planet's distance to each chart angle."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+    
+    def test_distances(self):
+        distances = self.calculator.calculate_distance_to_angles(100, 90, 0)
+        
+        self.assertEqual(set(distances), {'ASC', 'MC', 'DESC', 'IC'})
+        self.assertAlmostEqual(distances['ASC'], 10)
+        self.assertAlmostEqual(distances['MC'], 100)
+        self.assertAlmostEqual(distances['DESC'], 170)
+        self.assertAlmostEqual(distances['IC'], 80)
+    
+    def test_across_aries_point(self):
+        distances = self.calculator.calculate_distance_to_angles(350, 10, 280)
+        
+        self.assertAlmostEqual(distances['ASC'], 20)
+        self.assertAlmostEqual(distances['MC'], 70)
+        self.assertAlmostEqual(distances['DESC'], 160)
+
+
+class TestHouseMidpoints(unittest.TestCase):
+    """Tests for the midpoint of each house (bhava madhya)."""
+    
+    def setUp(self):
+        self.calculator = HousesCalculator()
+    
+    def test_midpoints(self):
+        cusps = self.calculator.calculate_porphyry_houses(100, 340)
+        
+        midpoints = self.calculator.calculate_house_midpoints(cusps)
+        
+        self.assertAlmostEqual(midpoints[1], 110)
+        self.assertAlmostEqual(midpoints[4], 180)
+        self.assertAlmostEqual(midpoints[11], 40)
+        # The 10th house runs from 340° across 0° Aries to 20°
+        self.assertAlmostEqual(midpoints[10], 0)
+    
+    def test_equal_houses(self):
+        midpoints = self.calculator.calculate_house_midpoints(self.calculator.calculate_houses(15, house_system='equal'))
+        
+        for house_num in range(1, 13):
+            self.assertAlmostEqual(midpoints[house_num], (30 * house_num) % 360)
+
+
+if __name__ == '__main__':
+    unittest.main()
\ No newline at end of file
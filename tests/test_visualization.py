@@ -0,0 +1,270 @@
+"""
+Tests for the visualization helper module.
+"""
+
+import os
+import tempfile
+import unittest
+
+import matplotlib
+matplotlib.use('Agg')
+import matplotlib.pyplot as plt
+import pandas as pd
+from PIL import Image
+
+from qucanft import VisualizationHelper
+from tests.helpers import make_planetary_data
+
+
+class TestSolarCondition(unittest.TestCase):
+    """Tests for combust and cazimi detection."""
+    
+    def setUp(self):
+        self.helper = VisualizationHelper()
+    
+    def tearDown(self):
+        plt.close('all')
+    
+    def test_condition_by_distance_from_sun(self):
+        self.assertEqual(self.helper.get_solar_condition(100.2, 100), 'Cazimi')
+        self.assertEqual(self.helper.get_solar_condition(95, 100), 'Combust')
+        self.assertIsNone(self.helper.get_solar_condition(120, 100))
+    
+    def test_condition_across_aries_point(self):
+        self.assertEqual(self.helper.get_solar_condition(359.9, 0.1), 'Cazimi')
+        self.assertEqual(self.helper.get_solar_condition(355, 2), 'Combust')
+    
+    def test_chart_markers_follow_condition(self):
+        planetary_data = make_planetary_data({'Sun': 100, 'Mercury': 105, 'Venus': 100.1, 'Mars': 200})
+        
+        fig = self.helper.create_natal_chart(planetary_data)
+        sizes = [collection.get_sizes()[0] for collection in fig.axes[0].collections]
+        
+        self.assertEqual(sizes, [200, 120, 350, 200])
+
+
+class TestAspectColors(unittest.TestCase):
+    """Tests for user-specified aspect colors."""
+    
+    def tearDown(self):
+        plt.close('all')
+    
+    def test_default_palette_by_nature(self):
+        helper = VisualizationHelper()
+        
+        self.assertEqual(helper.get_aspect_color('Trine', 'Harmonious'), ('blue', 0.6))
+        self.assertEqual(helper.get_aspect_color('Square', 'Challenging'), ('red', 0.6))
+        self.assertEqual(helper.get_aspect_color('Conjunction', 'Neutral'), ('gray', 0.4))
+    
+    def test_custom_color_overrides_nature(self):
+        helper = VisualizationHelper(aspect_colors={'Square': 'orange'})
+        
+        self.assertEqual(helper.get_aspect_color('Square', 'Challenging'), ('orange', 0.6))
+        self.assertEqual(helper.get_aspect_color('Opposition', 'Challenging'), ('red', 0.6))
+    
+    def test_chart_draws_custom_colors(self):
+        helper = VisualizationHelper(aspect_colors={'Square': 'orange'})
+        planetary_data = make_planetary_data({'Sun': 0, 'Mars': 90})
+        aspects_data = pd.DataFrame([
+            {'planet1': 'Sun', 'planet2': 'Mars', 'aspect': 'Square', 'nature': 'Challenging'}
+        ])
+        
+        fig = helper.create_natal_chart(planetary_data, aspects_data=aspects_data)
+        
+        self.assertEqual([line.get_color() for line in fig.axes[0].lines], ['orange'])
+
+
+class TestChartLegend(unittest.TestCase):
+    """Tests for the legend panel of chart wheels."""
+    
+    def setUp(self):
+        self.helper = VisualizationHelper()
+        self.planetary_data = make_planetary_data({'Sun': 0, 'Moon': 120})
+        self.aspects_data = pd.DataFrame([
+            {'planet1': 'Sun', 'planet2': 'Moon', 'aspect': 'Trine', 'symbol': '△',
+             'nature': 'Harmonious'}
+        ])
+    
+    def tearDown(self):
+        plt.close('all')
+    
+    def test_legend_lists_planets_and_aspects(self):
+        fig = self.helper.create_natal_chart(self.planetary_data, aspects_data=self.aspects_data,
+                                             show_legend=True)
+        
+        labels = [text.get_text() for text in fig.axes[0].get_legend().get_texts()]
+        
+        self.assertEqual(labels, ['☉ Sun', '☽ Moon', '△ Trine'])
+    
+    def test_legend_lists_houses(self):
+        house_cusps = {house_num: (house_num - 1) * 30 for house_num in range(1, 13)}
+        
+        fig = self.helper.create_natal_chart(self.planetary_data, house_cusps, show_legend=True)
+        
+        texts = [text.get_text() for text in fig.axes[0].texts]
+        self.assertTrue(any(text.startswith('1: Ascendant/Self') for text in texts))
+    
+    def test_no_legend_by_default(self):
+        fig = self.helper.create_natal_chart(self.planetary_data, aspects_data=self.aspects_data)
+        
+        self.assertIsNone(fig.axes[0].get_legend())
+
+
+class TestChartImageData(unittest.TestCase):
+    """Tests for embedding chart data in saved PNG images."""
+    
+    def setUp(self):
+        self.helper = VisualizationHelper()
+        self.directory = tempfile.TemporaryDirectory()
+        self.filename = os.path.join(self.directory.name, 'chart.png')
+        self.planetary_data = make_planetary_data({'Sun': 84.25, 'Moon': 201.5})
+        self.planetary_data['Date'] = '1990-06-15 14:30:00.000'
+        self.aspects_data = pd.DataFrame([
+            {'aspect': 'Trine', 'planet1': 'Sun', 'planet2': 'Moon', 'orb_difference': 2.75}
+        ])
+        self.house_cusps = {house_num: (house_num - 1) * 30.5 for house_num in range(1, 13)}
+    
+    def tearDown(self):
+        plt.close('all')
+        self.directory.cleanup()
+    
+    def test_chart_data_round_trip(self):
+        fig = self.helper.create_natal_chart(self.planetary_data, self.house_cusps)
+        self.helper.save_chart_image(fig, self.filename, self.planetary_data, self.aspects_data,
+                                     self.house_cusps, location='London, UK')
+        
+        chart = self.helper.load_chart_data_from_image(self.filename)
+        
+        self.assertEqual(chart['date'], '1990-06-15 14:30:00.000')
+        self.assertEqual(chart['location'], 'London, UK')
+        self.assertEqual(chart['house_cusps'], self.house_cusps)
+        self.assertEqual(list(chart['planetary_data']['Planet']), ['Sun', 'Moon'])
+        self.assertEqual(list(chart['planetary_data']['Ecliptic_Longitude']), [84.25, 201.5])
+        self.assertEqual(chart['aspects_data'].iloc[0]['aspect'], 'Trine')
+        self.assertEqual(chart['aspects_data'].iloc[0]['orb_difference'], 2.75)
+    
+    def test_chart_without_aspects(self):
+        fig = self.helper.create_natal_chart(self.planetary_data)
+        self.helper.save_chart_image(fig, self.filename, self.planetary_data)
+        
+        chart = self.helper.load_chart_data_from_image(self.filename)
+        
+        self.assertIsNone(chart['aspects_data'])
+        self.assertEqual(chart['house_cusps'], {})
+    
+    def test_image_without_chart_data(self):
+        fig = self.helper.create_natal_chart(self.planetary_data)
+        self.helper.save_chart_image(fig, self.filename)
+        
+        with self.assertRaises(ValueError):
+            self.helper.load_chart_data_from_image(self.filename)
+    
+    def test_non_png_rejected(self):
+        with open(self.filename, 'w') as f:
+            f.write('not an image')
+        
+        with self.assertRaises(ValueError):
+            self.helper.load_chart_data_from_image(self.filename)
+    
+    def test_other_image_format_rejected(self):
+        filename = os.path.join(self.directory.name, 'chart.gif')
+        Image.new('RGB', (4, 4)).save(filename, format='GIF')
+        
+        with self.assertRaises(ValueError):
+            self.helper.load_chart_data_from_image(filename)
+
+
+class TestChartSequence(unittest.TestCase):
+    """Tests for rendering sequences of chart frames."""
+    
+    def setUp(self):
+        plt.close('all')
+        self.helper = VisualizationHelper()
+        self.frames = [make_planetary_data({'Sun': 10 * i, 'Moon': 40 * i}) for i in range(3)]
+    
+    def tearDown(self):
+        plt.close('all')
+    
+    def test_sequence_of_figures(self):
+        figures = self.helper.create_chart_sequence(self.frames, chart_size=(2, 2))
+        
+        self.assertEqual(len(figures), 3)
+        self.assertEqual(len(plt.get_fignums()), 3)
+        self.assertTrue(all(tuple(fig.get_size_inches()) == (2, 2) for fig in figures))
+    
+    def test_rasterized_sequence_closes_figures(self):
+        images = self.helper.create_chart_sequence(self.frames, chart_size=(2, 2), rasterize=True)
+        
+        self.assertEqual(len(images), 3)
+        self.assertTrue(all(isinstance(image, Image.Image) for image in images))
+        self.assertEqual(images[0].mode, 'RGB')
+        self.assertEqual(plt.get_fignums(), [])
+    
+    def test_mismatched_frame_lists_rejected(self):
+        with self.assertRaises(ValueError):
+            self.helper.create_chart_sequence(self.frames, house_cusps_list=[None])
+
+
+class TestSaveAnimation(unittest.TestCase):
+    """Tests for exporting chart frames as an animated GIF."""
+    
+    def setUp(self):
+        plt.close('all')
+        self.helper = VisualizationHelper()
+        self.directory = tempfile.TemporaryDirectory()
+        self.filename = os.path.join(self.directory.name, 'motion.gif')
+        self.frames = [make_planetary_data({'Sun': 10 * i, 'Moon': 40 * i}) for i in range(3)]
+    
+    def tearDown(self):
+        plt.close('all')
+        self.directory.cleanup()
+    
+    def test_figures_saved_as_frames_and_closed(self):
+        figures = self.helper.create_chart_sequence(self.frames, chart_size=(2, 2))
+        
+        self.helper.save_animation(figures, self.filename, delay_ms=100)
+        
+        with Image.open(self.filename) as animation:
+            self.assertEqual(animation.format, 'GIF')
+            self.assertEqual(animation.n_frames, 3)
+        self.assertEqual(plt.get_fignums(), [])
+    
+    def test_figures_kept_open_on_request(self):
+        figures = self.helper.create_chart_sequence(self.frames, chart_size=(2, 2))
+        
+        self.helper.save_animation(figures, self.filename, close_figures=False)
+        
+        self.assertEqual(len(plt.get_fignums()), 3)
+    
+    def test_rasterized_frames(self):
+        images = self.helper.create_chart_sequence(self.frames, chart_size=(2, 2), rasterize=True)
+        
+        self.helper.save_animation(images, self.filename)
+        
+        with Image.open(self.filename) as animation:
+            self.assertEqual(animation.n_frames, 3)
+    
+    def test_no_frames_rejected(self):
+        with self.assertRaises(ValueError):
+            self.helper.save_animation([], self.filename)
+
+
+class TestGlyphs(unittest.TestCase):
+    """Tests for planet, sign and aspect glyphs."""
+    
+    def setUp(self):
+        self.helper = VisualizationHelper()
+    
+    def test_glyph_lookup(self):
+        self.assertEqual(self.helper.get_glyph('Sun'), '☉')
+        self.assertEqual(self.helper.get_glyph('Aries'), '♈')
+        self.assertEqual(self.helper.get_glyph('Trine'), '△')
+        self.assertEqual(self.helper.get_glyph('Vertex'), 'Vertex')
+    
+    def test_format_label(self):
+        self.assertEqual(self.helper.format_label('Sextile'), 'Sextile')
+        self.assertEqual(self.helper.format_label('Sextile', use_glyph=True), '⚹')
+
+
+if __name__ == '__main__':
+    unittest.main()
\ No newline at end of file
@@ -0,0 +1,612 @@
+"""
+Tests for the zodiac calculations module.
+"""
+
+import unittest
+from unittest import mock
+
+import pandas as pd
+from astropy.time import Time
+
+from qucanft import ZodiacCalculator
+from qucanft import zodiac
+from tests.helpers import ecliptic_to_equatorial, make_planetary_data
+
+
+# Julian Day of J2000.0
+J2000 = 2451545.0
+
+
+def make_ephemeris(start_longitude, daily_motion, days):
+    """Build a daily ephemeris for a planet moving steadily from J2000."""
+    rows = []
+    for day in range(days + 1):
+        ra, dec = ecliptic_to_equatorial(start_longitude + daily_motion * day)
+        rows.append({'datetime_jd': J2000 + day, 'RA': ra, 'DEC': dec})
+    return pd.DataFrame(rows)
+
+
+class TestPositionComponents(unittest.TestCase):
+    """Tests for splitting a longitude into sign, degrees, minutes and seconds."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_components_within_sign(self):
+        self.assertEqual(self.calculator.get_position_components(15.5), ('Aries', 15, 30, 0))
+        self.assertEqual(self.calculator.get_position_components(245.25), ('Sagittarius', 5, 15, 0))
+    
+    def test_components_match_formatted_string(self):
+        sign, deg, minutes, seconds = self.calculator.get_position_components(123.456)
+        
+        self.assertEqual(self.calculator.format_zodiac_position(123.456, include_symbol=False),
+                         f"{deg}°{minutes:02d}'{seconds:02d}\" {sign}")
+
+
+class TestChartRuler(unittest.TestCase):
+    """Tests for the ruler of the Ascendant's sign."""
+    
+    def test_ruler_of_rising_sign(self):
+        calculator = ZodiacCalculator()
+        
+        self.assertEqual(calculator.get_chart_ruler(10), 'Mars')
+        self.assertEqual(calculator.get_chart_ruler(130), 'Sun')
+        self.assertEqual(calculator.get_chart_ruler(350), 'Jupiter')
+
+
+class TestSignIngresses(unittest.TestCase):
+    """Tests for finding sign changes in an ephemeris."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_direct_ingress(self):
+        ingresses = self.calculator.find_sign_ingresses(make_ephemeris(25.5, 1.0, 10))
+        
+        self.assertEqual(len(ingresses), 1)
+        self.assertEqual(ingresses[0]['sign'], 'Taurus')
+        self.assertEqual(ingresses[0]['direction'], 'Direct')
+        self.assertAlmostEqual(ingresses[0]['jd'], J2000 + 4.5, delta=0.01)
+    
+    def test_retrograde_ingress(self):
+        ingresses = self.calculator.find_sign_ingresses(make_ephemeris(32.0, -0.8, 5))
+        
+        self.assertEqual([(ingress['sign'], ingress['direction']) for ingress in ingresses],
+                         [('Aries', 'Retrograde')])
+        self.assertAlmostEqual(ingresses[0]['jd'], J2000 + 2.5, delta=0.02)
+    
+    def test_ingress_across_aries_point(self):
+        ingresses = self.calculator.find_sign_ingresses(make_ephemeris(358.0, 1.0, 4))
+        
+        self.assertEqual(ingresses[0]['sign'], 'Aries')
+        self.assertAlmostEqual(ingresses[0]['jd'], J2000 + 2, delta=0.01)
+    
+    def test_no_ingress_within_sign(self):
+        self.assertEqual(self.calculator.find_sign_ingresses(make_ephemeris(40.0, 1.0, 10)), [])
+
+
+class TestDignityScoring(unittest.TestCase):
+    """Tests for essential dignity scoring with dispositor aspects."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_essential_dignities(self):
+        self.assertEqual(self.calculator.get_essential_dignities('Mars', 10), ['rulership'])
+        self.assertEqual(self.calculator.get_essential_dignities('Sun', 10), ['exaltation'])
+        self.assertEqual(self.calculator.get_essential_dignities('Venus', 10), ['detriment'])
+        self.assertEqual(self.calculator.get_essential_dignities('Saturn', 10), ['fall'])
+        self.assertEqual(self.calculator.calculate_essential_dignity('Saturn', 10), -4)
+    
+    def test_aspect_to_dispositor_adds_bonus(self):
+        aspects = pd.DataFrame([{'planet1': 'Venus', 'planet2': 'Sun', 'aspect': 'Sextile'}])
+        
+        self.assertEqual(self.calculator.calculate_full_dignity('Sun', 190), -4)
+        self.assertEqual(self.calculator.calculate_full_dignity('Sun', 190, aspects), -2)
+    
+    def test_aspects_to_other_planets_ignored(self):
+        aspects = pd.DataFrame([{'planet1': 'Sun', 'planet2': 'Mars', 'aspect': 'Trine'}])
+        
+        self.assertEqual(self.calculator.calculate_full_dignity('Sun', 190, aspects), -4)
+    
+    def test_face_rulers_in_chaldean_order(self):
+        self.assertEqual(self.calculator.get_face_ruler(5), 'Mars')
+        self.assertEqual(self.calculator.get_face_ruler(15), 'Sun')
+        self.assertEqual(self.calculator.get_face_ruler(25), 'Venus')
+        self.assertEqual(self.calculator.get_face_ruler(35), 'Mercury')
+        # The sequence wraps around so Pisces ends where Aries begins
+        self.assertEqual(self.calculator.get_face_ruler(355), 'Mars')
+    
+    def test_own_face_and_rulership(self):
+        self.assertEqual(self.calculator.calculate_full_dignity('Mars', 5), 6)
+    
+    def test_face_alone(self):
+        # The Sun has no other dignity in Gemini but rules its last face
+        self.assertEqual(self.calculator.calculate_full_dignity('Sun', 85), 1)
+
+
+class TestMoonAge(unittest.TestCase):
+    """Tests for the Moon's age from the Sun-Moon elongation."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_age_at_principal_phases(self):
+        self.assertAlmostEqual(self.calculator.calculate_moon_age(100, 100), 0)
+        self.assertAlmostEqual(self.calculator.calculate_moon_age(100, 190),
+                               ZodiacCalculator.SYNODIC_MONTH / 4)
+        self.assertAlmostEqual(self.calculator.calculate_moon_age(100, 280), 14.765294, places=5)
+    
+    def test_age_across_aries_point(self):
+        self.assertAlmostEqual(self.calculator.calculate_moon_age(350, 20),
+                               ZodiacCalculator.SYNODIC_MONTH / 12)
+        self.assertAlmostEqual(self.calculator.calculate_moon_age(20, 350),
+                               ZodiacCalculator.SYNODIC_MONTH * 11 / 12)
+
+
+class TestBesieged(unittest.TestCase):
+    """Tests for planets besieged between Mars and Saturn."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_planet_between_malefics(self):
+        self.assertTrue(self.calculator.is_besieged('Venus', {'Mars': 5, 'Venus': 10, 'Saturn': 15}))
+        self.assertTrue(self.calculator.is_besieged('Venus', {'Mars': 15, 'Venus': 10, 'Saturn': 5}))
+    
+    def test_planet_outside_malefics(self):
+        self.assertFalse(self.calculator.is_besieged('Venus', {'Mars': 5, 'Venus': 20, 'Saturn': 15}))
+    
+    def test_other_body_breaks_siege(self):
+        positions = {'Mars': 5, 'Venus': 10, 'Jupiter': 12, 'Saturn': 15}
+        
+        self.assertFalse(self.calculator.is_besieged('Venus', positions))
+    
+    def test_malefics_must_share_the_sign(self):
+        self.assertFalse(self.calculator.is_besieged('Venus', {'Mars': 28, 'Venus': 32, 'Saturn': 35}))
+    
+    def test_malefics_and_missing_planets(self):
+        self.assertFalse(self.calculator.is_besieged('Mars', {'Mars': 5, 'Saturn': 15}))
+        self.assertFalse(self.calculator.is_besieged('Venus', {'Venus': 10, 'Saturn': 15}))
+
+
+class TestAntiscia(unittest.TestCase):
+    """Tests for antiscia and the antiscia grid."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_antiscion_points(self):
+        self.assertAlmostEqual(self.calculator.calculate_antiscion(10), 170)
+        self.assertAlmostEqual(self.calculator.calculate_antiscion(100), 80)
+        self.assertAlmostEqual(self.calculator.calculate_antiscion(200), 340)
+        self.assertAlmostEqual(self.calculator.calculate_contra_antiscion(10), 350)
+        self.assertAlmostEqual(self.calculator.calculate_contra_antiscion(0), 0)
+    
+    def test_antiscia_grid(self):
+        planetary_data = pd.DataFrame([
+            {'Planet': 'Sun', 'Ecliptic_Longitude': 10},
+            {'Planet': 'Moon', 'Ecliptic_Longitude': 170.5},
+            {'Planet': 'Mars', 'Ecliptic_Longitude': 350},
+            {'Planet': 'Venus', 'Ecliptic_Longitude': 100}
+        ])
+        
+        grid = self.calculator.calculate_antiscia_grid(planetary_data)
+        
+        self.assertTrue(grid.loc['Sun', 'Moon'])
+        self.assertTrue(grid.loc['Moon', 'Sun'])
+        self.assertTrue(grid.loc['Sun', 'Mars'])
+        self.assertFalse(grid.loc['Sun', 'Sun'])
+        self.assertFalse(grid.loc['Sun', 'Venus'])
+        self.assertFalse(self.calculator.calculate_antiscia_grid(planetary_data, orb=0.25).loc['Sun', 'Moon'])
+
+
+class TestSingletons(unittest.TestCase):
+    """Tests for planets alone in an element or polarity."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_element_singletons(self):
+        planetary_data = make_planetary_data({
+            'Sun': 10, 'Moon': 100, 'Mercury': 40, 'Venus': 45, 'Mars': 70, 'Jupiter': 130
+        })
+        
+        self.assertEqual(self.calculator.find_singletons(planetary_data),
+                         {'element:Water': ['Moon'], 'element:Air': ['Mars']})
+    
+    def test_polarity_singleton(self):
+        planetary_data = make_planetary_data({'Sun': 10, 'Moon': 100, 'Mercury': 130})
+        
+        self.assertEqual(self.calculator.find_singletons(planetary_data),
+                         {'element:Water': ['Moon'], 'polarity:Yin': ['Moon']})
+
+
+class TestDwad(unittest.TestCase):
+    """Tests for the dwadashamsha sub-sign."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_first_dwads_of_aries(self):
+        self.assertEqual(self.calculator.calculate_dwad(1)['name'], 'Aries')
+        self.assertEqual(self.calculator.calculate_dwad(3)['name'], 'Taurus')
+    
+    def test_last_dwad_wraps(self):
+        # 29° Taurus is the twelfth dwad, which belongs to Aries
+        self.assertEqual(self.calculator.calculate_dwad(59)['name'], 'Aries')
+        self.assertEqual(self.calculator.calculate_dwad(345)['name'], 'Virgo')
+    
+    def test_dwad_honours_reference_offset(self):
+        self.calculator.set_reference_offset(24)
+        
+        self.assertEqual(self.calculator.calculate_dwad(25)['name'], 'Aries')
+        self.assertEqual(self.calculator.calculate_dwad(27)['name'], 'Taurus')
+
+
+class TestReferenceOffset(unittest.TestCase):
+    """Tests for a configurable zodiac reference point (e.g. an ayanamsa)."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+        self.calculator.set_reference_offset(24)
+    
+    def test_signs_shift_by_offset(self):
+        zodiac_info = self.calculator.ecliptic_to_zodiac(30)
+        
+        self.assertEqual(zodiac_info['name'], 'Aries')
+        self.assertAlmostEqual(zodiac_info['degree'], 6)
+        self.assertEqual(self.calculator.ecliptic_to_zodiac(10)['name'], 'Pisces')
+    
+    def test_to_zodiac_longitude(self):
+        self.assertAlmostEqual(self.calculator.to_zodiac_longitude(30), 6)
+        self.assertAlmostEqual(self.calculator.to_zodiac_longitude(10), 346)
+        self.assertAlmostEqual(ZodiacCalculator().to_zodiac_longitude(370), 10)
+    
+    def test_offset_normalized(self):
+        self.calculator.set_reference_offset(-24)
+        
+        self.assertEqual(self.calculator.reference_offset, 336)
+    
+    def test_round_trip(self):
+        for longitude in (0.0, 23.999999, 24.0, 137.123456, 359.5):
+            zodiac_info = self.calculator.ecliptic_to_zodiac(longitude)
+            round_trip = self.calculator.zodiac_to_ecliptic(zodiac_info['name'], zodiac_info['degree'])
+            
+            self.assertAlmostEqual((round_trip - longitude + 180) % 360 - 180, 0, places=9)
+    
+    def test_unknown_sign_rejected(self):
+        with self.assertRaises(ValueError):
+            self.calculator.zodiac_to_ecliptic('Ophiuchus', 10)
+    
+    def test_besieged_uses_offset_signs(self):
+        # Tropically Saturn has left Aries, but all three share sidereal Aries
+        positions = {'Mars': 25, 'Venus': 28, 'Saturn': 33}
+        
+        self.assertFalse(ZodiacCalculator().is_besieged('Venus', positions))
+        self.assertTrue(self.calculator.is_besieged('Venus', positions))
+
+
+class TestEclipticOfDate(unittest.TestCase):
+    """Tests for converting equatorial coordinates to the ecliptic of date."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_apparent_requires_obstime(self):
+        with self.assertRaises(ValueError):
+            self.calculator.ra_dec_to_ecliptic(198.378121, -7.783817, apparent=True)
+    
+    def test_equinox_of_date_lags_j2000(self):
+        # Precession moves longitudes forward by about 50" a year, so a fixed
+        # J2000 position reads about 0.1° lower against the 1992 equinox
+        j2000, _ = self.calculator.ra_dec_to_ecliptic(198.473554, -7.822251)
+        of_date, _ = self.calculator.ra_dec_to_ecliptic(198.473554, -7.822251,
+                                                        obstime='1992-10-13 00:00:00')
+        
+        self.assertAlmostEqual(j2000 - of_date, 0.1, delta=0.01)
+    
+    def test_zodiac_positions_stay_on_j2000(self):
+        # Rows from get_planet_positions carry apparent columns, which may be NaN
+        ra, dec = ecliptic_to_equatorial(84.25)
+        planetary_data = pd.DataFrame([{'Planet': 'Sun', 'Date': '1990-06-15 14:30:00.000',
+                                        'RA': ra, 'Dec': dec,
+                                        'RA_app': float('nan'), 'Dec_app': float('nan')}])
+        
+        positions = self.calculator.calculate_zodiac_positions(planetary_data)
+        
+        self.assertAlmostEqual(positions.iloc[0]['Ecliptic_Longitude'], 84.25, places=6)
+        self.assertEqual(positions.iloc[0]['Zodiac_Sign'], 'Gemini')
+
+
+class TestEphemerisLongitudes(unittest.TestCase):
+    """Tests for converting an ephemeris time series to ecliptic longitudes."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_series_converted_in_one_pass(self):
+        ephemeris = make_ephemeris(25.5, 1.0, 30)
+        
+        with mock.patch.object(zodiac, 'SkyCoord', wraps=zodiac.SkyCoord) as sky_coord:
+            samples = self.calculator.get_ephemeris_longitudes(ephemeris)
+        
+        self.assertEqual(sky_coord.call_count, 1)
+        self.assertEqual(len(samples), 31)
+        for day, (julian_day, longitude) in enumerate(samples):
+            self.assertAlmostEqual(julian_day, J2000 + day)
+            self.assertAlmostEqual(longitude, 25.5 + day, places=6)
+    
+    def test_apparent_longitudes_on_request(self):
+        ephemeris = make_ephemeris(25.5, 1.0, 3)
+        ephemeris['RA_app'] = ephemeris['RA']
+        ephemeris['DEC_app'] = ephemeris['DEC']
+        
+        samples = self.calculator.get_ephemeris_longitudes(ephemeris, apparent=True)
+        
+        for (julian_day, longitude), (_, row) in zip(samples, ephemeris.iterrows()):
+            expected, _ = self.calculator.ra_dec_to_ecliptic(row['RA_app'], row['DEC_app'],
+                                                             obstime=Time(julian_day, format='jd'),
+                                                             apparent=True)
+            self.assertAlmostEqual(longitude, expected, places=6)
+    
+    def test_j2000_longitudes_by_default(self):
+        ephemeris = make_ephemeris(25.5, 1.0, 3)
+        ephemeris['RA_app'] = float('nan')
+        ephemeris['DEC_app'] = float('nan')
+        
+        samples = self.calculator.get_ephemeris_longitudes(ephemeris)
+        
+        self.assertAlmostEqual(samples[2][1], 27.5, places=6)
+    
+    def test_samples_without_apparent_coordinates_skipped(self):
+        ephemeris = make_ephemeris(25.5, 1.0, 3)
+        ephemeris['RA_app'] = [ra if day != 1 else float('nan') for day, ra in enumerate(ephemeris['RA'])]
+        ephemeris['DEC_app'] = ephemeris['DEC']
+        
+        samples = self.calculator.get_ephemeris_longitudes(ephemeris, apparent=True)
+        
+        self.assertEqual([julian_day for julian_day, _ in samples], [J2000, J2000 + 2, J2000 + 3])
+    
+    def test_apparent_columns_required(self):
+        with self.assertRaises(ValueError):
+            self.calculator.get_ephemeris_longitudes(make_ephemeris(25.5, 1.0, 3), apparent=True)
+    
+    def test_empty_ephemeris(self):
+        self.assertEqual(self.calculator.get_ephemeris_longitudes(make_ephemeris(0, 1, 0).iloc[0:0]), [])
+
+
+class TestB1950Conversion(unittest.TestCase):
+    """Tests for converting to and from the B1950 FK4 frame."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_quasar_3c273(self):
+        # J2000 12h29m06.70s +02°03'08.6" is B1950 12h26m33.25s +02°19'42.5"
+        ra, dec = self.calculator.precess_to_b1950(187.277917, 2.052389)
+        
+        self.assertAlmostEqual(ra, 186.638542, delta=0.02)
+        self.assertAlmostEqual(dec, 2.328472, delta=0.02)
+    
+    def test_round_trip(self):
+        ra, dec = self.calculator.precess_from_b1950(*self.calculator.precess_to_b1950(83.633, 22.014))
+        
+        self.assertAlmostEqual(ra, 83.633, places=4)
+        self.assertAlmostEqual(dec, 22.014, places=4)
+
+
+class TestDecanRulers(unittest.TestCase):
+    """Tests for triplicity decan rulers."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_decans_follow_element(self):
+        self.assertEqual(self.calculator.get_decan_ruler(5), 'Mars')
+        self.assertEqual(self.calculator.get_decan_ruler(15), 'Sun')
+        self.assertEqual(self.calculator.get_decan_ruler(25), 'Jupiter')
+        self.assertEqual(self.calculator.get_decan_ruler(45), 'Mercury')
+    
+    def test_chart_decan_rulers(self):
+        rulers = self.calculator.calculate_decan_rulers(
+            make_planetary_data({'Sun': 15, 'Moon': 35, 'Mars': 55}))
+        
+        self.assertEqual(rulers, {'Sun': 'Sun', 'Moon': 'Venus', 'Mars': 'Saturn'})
+    
+    def test_requires_longitudes(self):
+        with self.assertRaises(ValueError):
+            self.calculator.calculate_decan_rulers(pd.DataFrame({'Planet': ['Sun']}))
+
+
+class TestFinalDispositors(unittest.TestCase):
+    """Tests for following dispositor chains."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_planet_in_own_sign(self):
+        # Sun in Libra -> Venus in Cancer -> Moon in Aries -> Mars in Aries
+        finals, loops = self.calculator.find_final_dispositors(
+            {'Sun': 190, 'Venus': 100, 'Moon': 20, 'Mars': 10})
+        
+        self.assertEqual(finals, ['Mars'])
+        self.assertEqual(loops, [])
+    
+    def test_mutual_reception_loop(self):
+        # Venus in Aries and Mars in Taurus dispose of each other
+        finals, loops = self.calculator.find_final_dispositors(
+            {'Venus': 5, 'Mars': 40, 'Sun': 190, 'Moon': 250})
+        
+        self.assertEqual(finals, [])
+        self.assertEqual(loops, [['Mars', 'Venus']])
+
+
+class TestLunarPhase(unittest.TestCase):
+    """Tests for naming the lunar phase from the Sun-Moon elongation."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_phases(self):
+        self.assertEqual(self.calculator.get_lunar_phase(100, 100), 'New Moon')
+        self.assertEqual(self.calculator.get_lunar_phase(100, 150), 'Waxing Crescent')
+        self.assertEqual(self.calculator.get_lunar_phase(100, 280), 'Full Moon')
+        self.assertEqual(self.calculator.get_lunar_phase(350, 330), 'Waning Crescent')
+
+
+class TestArabicParts(unittest.TestCase):
+    """Tests for the preset Arabic parts."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+        self.positions = {'Sun': 100, 'Moon': 40, 'Venus': 50}
+    
+    def test_fortune_by_day_and_night(self):
+        self.assertAlmostEqual(self.calculator.calculate_named_part('Fortune', 10, self.positions), 310)
+        self.assertAlmostEqual(
+            self.calculator.calculate_named_part('Fortune', 10, self.positions, is_day=False), 70)
+        # The Part of Spirit by night falls where Fortune does by day
+        self.assertAlmostEqual(
+            self.calculator.calculate_named_part('Spirit', 10, self.positions, is_day=False), 310)
+    
+    def test_marriage_not_reversed(self):
+        # Ascendant + Descendant - Venus
+        self.assertAlmostEqual(self.calculator.calculate_named_part('Marriage', 10, self.positions), 150)
+        self.assertAlmostEqual(
+            self.calculator.calculate_named_part('Marriage', 10, self.positions, is_day=False), 150)
+    
+    def test_invalid_parts_rejected(self):
+        with self.assertRaises(ValueError):
+            self.calculator.calculate_named_part('Commerce', 10, self.positions)
+        with self.assertRaises(ValueError):
+            self.calculator.calculate_named_part('Children', 10, self.positions)
+
+
+class TestAlmuten(unittest.TestCase):
+    """Tests for triplicity and term rulers and the almuten of a degree."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_triplicity_ruler_by_sect(self):
+        self.assertEqual(self.calculator.get_triplicity_ruler(5), 'Sun')
+        self.assertEqual(self.calculator.get_triplicity_ruler(5, is_day=False), 'Jupiter')
+        self.assertEqual(self.calculator.get_triplicity_ruler(40, is_day=False), 'Moon')
+    
+    def test_term_ruler(self):
+        self.assertEqual(self.calculator.get_term_ruler(5), 'Jupiter')
+        self.assertEqual(self.calculator.get_term_ruler(6), 'Venus')
+        self.assertEqual(self.calculator.get_term_ruler(29.9), 'Saturn')
+        self.assertEqual(self.calculator.get_term_ruler(40), 'Mercury')
+    
+    def test_almuten(self):
+        # By day the exalted Sun also rules the fire triplicity and outscores Mars
+        self.assertEqual(self.calculator.calculate_almuten(5), 'Sun')
+        # At 22° Aries by night Mars holds both rulership and term
+        self.assertEqual(self.calculator.calculate_almuten(22, is_day=False), 'Mars')
+
+
+class TestPolarityBalance(unittest.TestCase):
+    """Tests for counting planets in positive and negative signs."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_counts(self):
+        planetary_data = make_planetary_data({'Sun': 0, 'Moon': 40, 'Mercury': 70, 'Venus': 100, 'Mars': 130})
+        
+        self.assertEqual(self.calculator.calculate_polarity_balance(planetary_data), (3, 2))
+    
+    def test_requires_longitudes(self):
+        with self.assertRaises(ValueError):
+            self.calculator.calculate_polarity_balance(pd.DataFrame({'Planet': ['Sun']}))
+
+
+class TestOppositeSign(unittest.TestCase):
+    """Tests for looking up the opposite zodiac sign."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_opposite_sign(self):
+        self.assertEqual(self.calculator.get_opposite_sign('Aries')['name'], 'Libra')
+        self.assertEqual(self.calculator.get_opposite_sign('pisces')['name'], 'Virgo')
+    
+    def test_returns_copy(self):
+        self.calculator.get_opposite_sign('Aries')['ruler'] = 'Nobody'
+        
+        self.assertEqual(self.calculator.get_opposite_sign('Aries')['ruler'], 'Venus')
+    
+    def test_unknown_sign(self):
+        self.assertIsNone(self.calculator.get_opposite_sign('Ophiuchus'))
+
+
+class TestAnnualProfection(unittest.TestCase):
+    """Tests for profecting the Ascendant one sign per year."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_profections(self):
+        self.assertEqual(self.calculator.calculate_annual_profection('Aries', 0),
+                         {'sign': 'Aries', 'house': 1, 'year_lord': 'Mars'})
+        self.assertEqual(self.calculator.calculate_annual_profection('leo', 5),
+                         {'sign': 'Capricorn', 'house': 6, 'year_lord': 'Saturn'})
+    
+    def test_cycle_repeats_every_twelve_years(self):
+        self.assertEqual(self.calculator.calculate_annual_profection('Aries', 13),
+                         self.calculator.calculate_annual_profection('Aries', 1))
+    
+    def test_invalid_input_rejected(self):
+        with self.assertRaises(ValueError):
+            self.calculator.calculate_annual_profection('Aries', -1)
+        with self.assertRaises(ValueError):
+            self.calculator.calculate_annual_profection('Ophiuchus', 30)
+
+
+class TestZodiacalReleasing(unittest.TestCase):
+    """Tests for first-level zodiacal releasing periods."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_periods_from_cancer(self):
+        periods = self.calculator.calculate_zodiacal_releasing('Cancer', 50)
+        
+        self.assertEqual([period['sign'] for period in periods], ['Cancer', 'Leo', 'Virgo'])
+        self.assertEqual([period['ruler'] for period in periods], ['Moon', 'Sun', 'Mercury'])
+        self.assertEqual([(period['start_year'], period['end_year']) for period in periods],
+                         [(0, 25), (25, 44), (44, 64)])
+    
+    def test_periods_wrap_past_pisces(self):
+        periods = self.calculator.calculate_zodiacal_releasing('pisces', 20)
+        
+        self.assertEqual([period['sign'] for period in periods], ['Pisces', 'Aries'])
+        self.assertEqual(periods[1]['years'], 15)
+    
+    def test_unknown_sign_rejected(self):
+        with self.assertRaises(ValueError):
+            self.calculator.calculate_zodiacal_releasing('Ophiuchus', 50)
+
+
+class TestTriplicityLords(unittest.TestCase):
+    """Tests for the sequence of triplicity lords."""
+    
+    def setUp(self):
+        self.calculator = ZodiacCalculator()
+    
+    def test_sect_lord_first(self):
+        self.assertEqual(self.calculator.get_triplicity_lord_sequence('Leo'), ['Sun', 'Jupiter', 'Saturn'])
+        self.assertEqual(self.calculator.get_triplicity_lord_sequence('Leo', is_day=False),
+                         ['Jupiter', 'Sun', 'Saturn'])
+        self.assertEqual(self.calculator.get_triplicity_lord_sequence('Scorpio', is_day=False),
+                         ['Mars', 'Venus', 'Moon'])
+    
+    def test_unknown_sign_rejected(self):
+        with self.assertRaises(ValueError):
+            self.calculator.get_triplicity_lord_sequence('Ophiuchus')
+
+
+if __name__ == '__main__':
+    unittest.main()
\ No newline at end of file